@@ -1,6 +1,7 @@
 package main
 
 import (
+	"fmt"
 	"log"
 	"os"
 	"path/filepath"
@@ -8,6 +9,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/papadavis47/libros/internal/config"
 	"github.com/papadavis47/libros/internal/database"
+	"github.com/papadavis47/libros/internal/styles"
 	"github.com/papadavis47/libros/internal/ui"
 )
 
@@ -32,6 +34,13 @@ func main() {
 		log.Printf("Warning: Could not load theme config, using defaults: %v", err)
 	}
 
+	// Cache the settings read while rendering, so frames don't re-read the config file
+	config.LoadSettings()
+
+	// Render with the detected or configured color support
+	// Theme colors fall back to the nearest color the terminal can show
+	styles.ApplyColorProfile()
+
 	// Set database path to ~/.libros/books.db
 	dbPath := filepath.Join(librosDir, "books.db")
 
@@ -39,7 +48,11 @@ func main() {
 	// This will create the database file if it doesn't exist
 	db, err := database.New(dbPath)
 	if err != nil {
-		log.Fatal(err)
+		// Explain the failure and how to recover, and exit non-zero for scripts
+		fmt.Fprintf(os.Stderr, "Libros could not open its database at %s\n\n", dbPath)
+		fmt.Fprintf(os.Stderr, "  Error: %v\n\n", err)
+		fmt.Fprintf(os.Stderr, "  %s\n", database.OpenErrorHint(err))
+		os.Exit(1)
 	}
 	// Ensure database connection is closed when the program exits
 	defer db.Close()
@@ -0,0 +1,57 @@
+package config
+
+import "sync"
+
+// Settings holds the options read while screens render. Reading them through
+// the Get* accessors would stat and decode the config file on every call, and
+// these are read many times a frame, so they are loaded once into a cache that
+// is refreshed whenever the config is saved.
+type Settings struct {
+	FullWidth bool              // Whether titles use full-width glyphs and text is letter-spaced
+	TypeIcons bool              // Whether book types are shown with an emoji icon
+	Help      map[string]string // Help line overrides from the [help] section
+	Language  string            // Interface language as a lowercase language code
+	PinHeader bool              // Whether long screens keep their title in place while the rest scrolls
+}
+
+var (
+	settingsMu     sync.RWMutex
+	settingsCache  Settings
+	settingsLoaded bool
+)
+
+// LoadSettings reads the config file into the settings cache
+// It runs at startup; afterwards the cache changes only when the config is saved
+func LoadSettings() {
+	// LoadConfig falls back to the defaults on any error, so the config is always usable
+	config, _ := LoadConfig()
+	storeSettings(config)
+}
+
+// Cached returns the cached settings, loading them the first time they are needed
+func Cached() Settings {
+	settingsMu.RLock()
+	loaded := settingsLoaded
+	settingsMu.RUnlock()
+	if !loaded {
+		LoadSettings()
+	}
+
+	settingsMu.RLock()
+	defer settingsMu.RUnlock()
+	return settingsCache
+}
+
+// storeSettings replaces the cached settings with those of config
+func storeSettings(config Config) {
+	settingsMu.Lock()
+	defer settingsMu.Unlock()
+	settingsCache = Settings{
+		FullWidth: config.FullWidth,
+		TypeIcons: config.TypeIcons,
+		Help:      config.Help,
+		Language:  normalizeLanguage(config.Language),
+		PinHeader: config.PinHeader,
+	}
+	settingsLoaded = true
+}
@@ -1,21 +1,76 @@
 package config
 
 import (
+	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
 
 	"github.com/BurntSushi/toml"
+	"github.com/papadavis47/libros/internal/constants"
+	"github.com/papadavis47/libros/internal/models"
 )
 
 // Config represents the application configuration
 type Config struct {
-	Theme Theme `toml:"theme"`
+	Theme             Theme               `toml:"theme"`
+	NotesMaxLength    int                 `toml:"notes_max_length"`         // Maximum characters allowed in book notes
+	NotesHeight       int                 `toml:"notes_height"`             // Lines shown by the notes textarea on add/edit
+	ListNotesLength   int                 `toml:"list_notes_length"`        // Characters of notes shown on each book list card
+	MessageTimeout    int                 `toml:"message_timeout"`          // Seconds before success messages disappear; 0 keeps them until you move on
+	Keybindings       map[string][]string `toml:"keybindings,omitempty"`    // Action name to keys, overriding the defaults
+	Help              map[string]string   `toml:"help,omitempty"`           // Help line name to text, overriding the defaults
+	Menu              []string            `toml:"menu,omitempty"`           // Main menu entries in display order
+	BackupOverwrite   bool                `toml:"backup_overwrite"`         // Whether a backup may replace an existing books.db.bak without asking
+	DefaultSort       string              `toml:"default_sort"`             // Field the book list is sorted by: added, title or author
+	DefaultSortDir    string              `toml:"default_sort_dir"`         // Book list sort direction: asc or desc
+	DefaultBookType   string              `toml:"default_book_type"`        // Type preselected on the add form
+	ConfirmBeforeSave bool                `toml:"confirm_before_save"`      // Whether the add form shows a summary to confirm before saving
+	RememberType      bool                `toml:"remember_type"`            // Whether the add form reopens on the type of the last book saved
+	NotesTemplate     string              `toml:"notes_template"`           // Text the add form's notes field starts with
+	TypeIcons         bool                `toml:"type_icons"`               // Whether book types are shown with an emoji icon
+	ResumeSession     bool                `toml:"resume_session"`           // Whether startup reopens the last list or book viewed
+	FullWidth         bool                `toml:"full_width"`               // Whether titles use full-width glyphs and text is letter-spaced
+	PinHeader         bool                `toml:"pin_header"`               // Whether long screens keep their title in place while the rest scrolls
+	ExportExclude     []string            `toml:"export_exclude,omitempty"` // Optional fields left out of JSON and JSON Lines exports
+	Attention         []string            `toml:"attention,omitempty"`      // Checks run by the Needs Attention report, in display order
+	Language          string              `toml:"language"`                 // Language code of the interface text, e.g. "en" or "es"
+	ColorProfile      string              `toml:"color_profile"`            // Colors to render with: auto, truecolor, 256, 16 or none
+}
+
+// ParseError reports that the config file exists but could not be decoded
+// Callers can tell it apart from a missing file or an unreadable home directory
+type ParseError struct {
+	Path string // Location of the config file that failed to parse
+	Err  error  // Underlying TOML decode error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("could not parse %s: %v", e.Path, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
 }
 
 // DefaultConfig returns the default configuration
 func DefaultConfig() Config {
 	return Config{
-		Theme: DefaultTheme,
+		Theme:           DefaultTheme,
+		NotesMaxLength:  constants.NotesMaxLength,
+		NotesHeight:     constants.NotesHeight,
+		ListNotesLength: constants.ListNotesLength,
+		BackupOverwrite: true,
+		DefaultSort:     SortAdded,
+		DefaultSortDir:  SortDesc,
+		DefaultBookType: string(models.Paperback),
+		TypeIcons:       true,
+		ResumeSession:   true,
+		RememberType:    true,
+		FullWidth:       true,
+		PinHeader:       true,
+		Language:        DefaultLanguage,
+		ColorProfile:    ColorProfileAuto,
 	}
 }
 
@@ -35,10 +90,11 @@ func LoadConfig() (Config, error) {
 		return config, nil
 	}
 
-	// Load existing config
-	var config Config
+	// Load existing config over the defaults, so options missing from
+	// older files keep their default values
+	config := DefaultConfig()
 	if _, err := toml.DecodeFile(configPath, &config); err != nil {
-		return DefaultConfig(), err
+		return DefaultConfig(), &ParseError{Path: configPath, Err: err}
 	}
 
 	return config, nil
@@ -65,7 +121,13 @@ func SaveConfig(config Config) error {
 
 	// Encode the config to TOML
 	encoder := toml.NewEncoder(file)
-	return encoder.Encode(config)
+	if err := encoder.Encode(config); err != nil {
+		return err
+	}
+
+	// Keep the settings read while rendering in step with the file
+	storeSettings(config)
+	return nil
 }
 
 // UpdateTheme updates the theme in the configuration and saves it
@@ -80,6 +142,59 @@ func UpdateTheme(theme Theme) error {
 	return SaveConfig(config)
 }
 
+// UpdateNotesHeight updates the notes textarea height in the configuration and saves it
+// A config file that fails to parse is left alone and its *ParseError returned
+func UpdateNotesHeight(height int) error {
+	config, err := loadConfigForUpdate()
+	if err != nil {
+		return err
+	}
+
+	config.NotesHeight = normalizeNotesHeight(height)
+	return SaveConfig(config)
+}
+
+// UpdateSort updates the book list sort in the configuration and saves it
+// A config file that fails to parse is left alone and its *ParseError returned
+func UpdateSort(field, dir string) error {
+	config, err := loadConfigForUpdate()
+	if err != nil {
+		return err
+	}
+
+	config.DefaultSort, config.DefaultSortDir = normalizeSort(field, dir)
+	return SaveConfig(config)
+}
+
+// UpdateExportExclude updates the fields left out of JSON exports in the configuration and saves it
+// A config file that fails to parse is left alone and its *ParseError returned
+func UpdateExportExclude(fields []string) error {
+	config, err := loadConfigForUpdate()
+	if err != nil {
+		return err
+	}
+
+	config.ExportExclude = fields
+	return SaveConfig(config)
+}
+
+// loadConfigForUpdate loads the configuration for an update to change and save
+// A file that fails to parse is returned as its *ParseError rather than replaced
+// with the defaults, so a hand-edited file can still be fixed, or reset from the
+// menu notice; any other problem starts from the defaults as before
+func loadConfigForUpdate() (Config, error) {
+	config, err := LoadConfig()
+	var parseErr *ParseError
+	if errors.As(err, &parseErr) {
+		return config, parseErr
+	}
+	if err != nil {
+		// If we can't load config, create a new one
+		config = DefaultConfig()
+	}
+	return config, nil
+}
+
 // GetCurrentTheme returns the current theme from the configuration
 func GetCurrentTheme() Theme {
 	config, err := LoadConfig()
@@ -89,6 +204,23 @@ func GetCurrentTheme() Theme {
 	return config.Theme
 }
 
+// CheckConfig reports whether the config file is corrupt
+// It returns a *ParseError when the file exists but cannot be parsed, and nil otherwise,
+// so a missing file or other load problem does not trigger a corruption notice
+func CheckConfig() error {
+	_, err := LoadConfig()
+	var parseErr *ParseError
+	if errors.As(err, &parseErr) {
+		return parseErr
+	}
+	return nil
+}
+
+// ResetConfig replaces the config file with a valid default configuration
+func ResetConfig() error {
+	return SaveConfig(DefaultConfig())
+}
+
 // getConfigPath returns the path to the configuration file
 func getConfigPath() (string, error) {
 	homeDir, err := os.UserHomeDir()
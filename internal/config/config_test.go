@@ -0,0 +1,598 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/papadavis47/libros/internal/constants"
+	"github.com/papadavis47/libros/internal/models"
+)
+
+// useTempHome points the config file at a temporary home directory for one test
+// It returns the path of the config file inside that directory
+func useTempHome(t *testing.T) string {
+	t.Helper()
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	return filepath.Join(home, ".libros", "theme.toml")
+}
+
+// TestCheckConfig tests that only a corrupt config file is reported as a problem
+// A missing file is created with defaults and a valid file loads cleanly
+func TestCheckConfig(t *testing.T) {
+	tests := []struct {
+		name      string
+		contents  string // Empty leaves the file missing
+		wantParse bool
+	}{
+		{"missing file", "", false},
+		{"valid file", "notes_max_length = 500\n", false},
+		{"corrupt file", "notes_max_length = = \n[theme\n", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := useTempHome(t)
+			if tt.contents != "" {
+				if err := os.MkdirAll(filepath.Dir(configPath), 0755); err != nil {
+					t.Fatalf("Failed to create config dir: %v", err)
+				}
+				if err := os.WriteFile(configPath, []byte(tt.contents), 0644); err != nil {
+					t.Fatalf("Failed to write config: %v", err)
+				}
+			}
+
+			err := CheckConfig()
+			var parseErr *ParseError
+			if got := errors.As(err, &parseErr); got != tt.wantParse {
+				t.Errorf("CheckConfig() = %v, want parse error: %v", err, tt.wantParse)
+			}
+		})
+	}
+}
+
+// TestResetConfig tests that resetting rewrites a corrupt file with valid defaults
+func TestResetConfig(t *testing.T) {
+	configPath := useTempHome(t)
+	if err := os.MkdirAll(filepath.Dir(configPath), 0755); err != nil {
+		t.Fatalf("Failed to create config dir: %v", err)
+	}
+	if err := os.WriteFile(configPath, []byte("not = valid = toml"), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	if err := ResetConfig(); err != nil {
+		t.Fatalf("ResetConfig() returned error: %v", err)
+	}
+
+	config, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("LoadConfig() after reset returned error: %v", err)
+	}
+	if config.Theme.Name != DefaultTheme.Name {
+		t.Errorf("Theme after reset = %q, want %q", config.Theme.Name, DefaultTheme.Name)
+	}
+	if err := CheckConfig(); err != nil {
+		t.Errorf("CheckConfig() after reset = %v, want nil", err)
+	}
+}
+
+// TestGetBackupOverwrite tests that backups overwrite by default, including for
+// config files written before the option existed, and that false is honored
+func TestGetBackupOverwrite(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents string // Empty leaves the file missing
+		expected bool
+	}{
+		{"missing file", "", true},
+		{"option not set", "notes_max_length = 500\n", true},
+		{"overwrite disabled", "backup_overwrite = false\n", false},
+		{"overwrite enabled", "backup_overwrite = true\n", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := useTempHome(t)
+			if tt.contents != "" {
+				if err := os.MkdirAll(filepath.Dir(configPath), 0755); err != nil {
+					t.Fatalf("Failed to create config dir: %v", err)
+				}
+				if err := os.WriteFile(configPath, []byte(tt.contents), 0644); err != nil {
+					t.Fatalf("Failed to write config: %v", err)
+				}
+			}
+
+			if got := GetBackupOverwrite(); got != tt.expected {
+				t.Errorf("GetBackupOverwrite() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestGetSort tests that the book list sort is read from the config file
+// and that invalid values fall back to newest first
+func TestGetSort(t *testing.T) {
+	tests := []struct {
+		name      string
+		contents  string // Empty leaves the file missing
+		wantField string
+		wantDir   string
+	}{
+		{"missing file", "", SortAdded, SortDesc},
+		{"option not set", "notes_max_length = 500\n", SortAdded, SortDesc},
+		{"title ascending", "default_sort = \"title\"\ndefault_sort_dir = \"asc\"\n", SortTitle, SortAsc},
+		{"mixed case", "default_sort = \" Author \"\ndefault_sort_dir = \"DESC\"\n", SortAuthor, SortDesc},
+		{"unknown field", "default_sort = \"rating\"\ndefault_sort_dir = \"asc\"\n", SortAdded, SortDesc},
+		{"unknown direction", "default_sort = \"title\"\ndefault_sort_dir = \"sideways\"\n", SortTitle, SortDesc},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := useTempHome(t)
+			if tt.contents != "" {
+				if err := os.MkdirAll(filepath.Dir(configPath), 0755); err != nil {
+					t.Fatalf("Failed to create config dir: %v", err)
+				}
+				if err := os.WriteFile(configPath, []byte(tt.contents), 0644); err != nil {
+					t.Fatalf("Failed to write config: %v", err)
+				}
+			}
+
+			field, dir := GetSort()
+			if field != tt.wantField || dir != tt.wantDir {
+				t.Errorf("GetSort() = %q, %q, want %q, %q", field, dir, tt.wantField, tt.wantDir)
+			}
+		})
+	}
+}
+
+// TestUpdateSort tests that a changed sort is saved and read back
+func TestUpdateSort(t *testing.T) {
+	useTempHome(t)
+
+	if err := UpdateSort(SortAuthor, SortAsc); err != nil {
+		t.Fatalf("UpdateSort() returned error: %v", err)
+	}
+	if field, dir := GetSort(); field != SortAuthor || dir != SortAsc {
+		t.Errorf("GetSort() after UpdateSort = %q, %q, want %q, %q", field, dir, SortAuthor, SortAsc)
+	}
+}
+
+// TestUpdateSort_KeepsCorruptConfig tests that a config file that fails to parse
+// is reported rather than overwritten with the defaults
+func TestUpdateSort_KeepsCorruptConfig(t *testing.T) {
+	configPath := writeCorruptConfig(t)
+
+	err := UpdateSort(SortAuthor, SortAsc)
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("UpdateSort() with a corrupt config returned %v, want a *ParseError", err)
+	}
+	checkConfigUnchanged(t, configPath)
+}
+
+// writeCorruptConfig writes a config file that fails to parse in a temporary home
+// It returns the path of the config file
+func writeCorruptConfig(t *testing.T) string {
+	t.Helper()
+	configPath := useTempHome(t)
+	if err := os.MkdirAll(filepath.Dir(configPath), 0755); err != nil {
+		t.Fatalf("Failed to create config dir: %v", err)
+	}
+	if err := os.WriteFile(configPath, []byte(corruptConfig), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	return configPath
+}
+
+// checkConfigUnchanged fails the test if the file written by writeCorruptConfig was changed
+func checkConfigUnchanged(t *testing.T, configPath string) {
+	t.Helper()
+	contents, err := os.ReadFile(configPath)
+	if err != nil {
+		t.Fatalf("Failed to read config: %v", err)
+	}
+	if string(contents) != corruptConfig {
+		t.Errorf("Config file = %q, want it left as %q", contents, corruptConfig)
+	}
+}
+
+// corruptConfig is a hand-edited config with a typo that stops it parsing
+const corruptConfig = "notes_height = 9\nfull_width = flase\n"
+
+// TestNormalizeNotesHeight tests that the notes height stays within the allowed range
+func TestNormalizeNotesHeight(t *testing.T) {
+	tests := []struct {
+		height   int
+		expected int
+	}{
+		{0, constants.NotesHeight},
+		{-3, constants.NotesMinHeight},
+		{1, constants.NotesMinHeight},
+		{8, 8},
+		{100, constants.NotesMaxHeight},
+	}
+
+	for _, tt := range tests {
+		if got := normalizeNotesHeight(tt.height); got != tt.expected {
+			t.Errorf("normalizeNotesHeight(%d) = %d, want %d", tt.height, got, tt.expected)
+		}
+	}
+}
+
+// TestUpdateNotesHeight tests that a chosen notes height is saved and read back
+func TestUpdateNotesHeight(t *testing.T) {
+	useTempHome(t)
+
+	if got := GetNotesHeight(); got != constants.NotesHeight {
+		t.Errorf("GetNotesHeight() with no config = %d, want %d", got, constants.NotesHeight)
+	}
+	if err := UpdateNotesHeight(9); err != nil {
+		t.Fatalf("UpdateNotesHeight() returned error: %v", err)
+	}
+	if got := GetNotesHeight(); got != 9 {
+		t.Errorf("GetNotesHeight() after update = %d, want 9", got)
+	}
+}
+
+// TestUpdateNotesHeight_KeepsCorruptConfig tests that resizing the notes does not
+// replace a config file that fails to parse
+func TestUpdateNotesHeight_KeepsCorruptConfig(t *testing.T) {
+	configPath := writeCorruptConfig(t)
+
+	err := UpdateNotesHeight(12)
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("UpdateNotesHeight() with a corrupt config returned %v, want a *ParseError", err)
+	}
+	checkConfigUnchanged(t, configPath)
+}
+
+// TestAccessibleThemes tests that the high-contrast and color-blind-safe themes
+// are selectable and keep a selected text color distinct from their primary color
+func TestAccessibleThemes(t *testing.T) {
+	for _, value := range []string{"high_contrast", "color_blind"} {
+		theme := GetThemeByValue(value)
+		if theme.Name == DefaultTheme.Name {
+			t.Errorf("GetThemeByValue(%q) fell back to the default theme", value)
+		}
+		if GetThemeByName(theme.Name).Name != theme.Name {
+			t.Errorf("GetThemeByName(%q) did not find the theme in AllThemes", theme.Name)
+		}
+		if theme.SelectedText() == theme.PrimaryColor {
+			t.Errorf("%s selected text color matches its primary color", theme.Name)
+		}
+	}
+
+	if got := HighContrastTheme.SelectedText(); got != "#000000" {
+		t.Errorf("HighContrastTheme.SelectedText() = %q, want %q", got, "#000000")
+	}
+	// Themes saved before selected_text_color existed keep white text
+	if got := DefaultTheme.SelectedText(); got != "#FFFFFF" {
+		t.Errorf("DefaultTheme.SelectedText() = %q, want %q", got, "#FFFFFF")
+	}
+}
+
+// TestGetDefaultBookType tests that the add form's default type is read from the
+// config file and that unknown types fall back to paperback
+func TestGetDefaultBookType(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents string // Empty leaves the file missing
+		expected models.BookType
+	}{
+		{"missing file", "", models.Paperback},
+		{"option not set", "notes_max_length = 500\n", models.Paperback},
+		{"audio", "default_book_type = \"audio\"\n", models.Audio},
+		{"mixed case", "default_book_type = \" Digital \"\n", models.Digital},
+		{"unknown type", "default_book_type = \"scroll\"\n", models.Paperback},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := useTempHome(t)
+			if tt.contents != "" {
+				if err := os.MkdirAll(filepath.Dir(configPath), 0755); err != nil {
+					t.Fatalf("Failed to create config dir: %v", err)
+				}
+				if err := os.WriteFile(configPath, []byte(tt.contents), 0644); err != nil {
+					t.Fatalf("Failed to write config: %v", err)
+				}
+			}
+
+			if got := GetDefaultBookType(); got != tt.expected {
+				t.Errorf("GetDefaultBookType() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestGetConfirmBeforeSave tests that the add form review step is off by default
+// and can be turned on in the config file
+func TestGetConfirmBeforeSave(t *testing.T) {
+	configPath := useTempHome(t)
+	if GetConfirmBeforeSave() {
+		t.Error("GetConfirmBeforeSave() with no config file = true, want false")
+	}
+
+	if err := os.WriteFile(configPath, []byte("confirm_before_save = true\n"), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	if !GetConfirmBeforeSave() {
+		t.Error("GetConfirmBeforeSave() with confirm_before_save = true = false, want true")
+	}
+}
+
+// TestGetNotesTemplate tests that a multi-line notes template is read from the config file
+func TestGetNotesTemplate(t *testing.T) {
+	configPath := useTempHome(t)
+	if got := GetNotesTemplate(); got != "" {
+		t.Errorf("GetNotesTemplate() with no config file = %q, want empty", got)
+	}
+
+	contents := "notes_template = \"\"\"\nSummary:\n\nFavorite quote:\n\"\"\"\n"
+	if err := os.WriteFile(configPath, []byte(contents), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	want := "Summary:\n\nFavorite quote:\n"
+	if got := GetNotesTemplate(); got != want {
+		t.Errorf("GetNotesTemplate() = %q, want %q", got, want)
+	}
+}
+
+// TestGetTypeIcons tests that type icons are on by default, including for config
+// files written before the option existed, and can be turned off
+func TestGetTypeIcons(t *testing.T) {
+	configPath := useTempHome(t)
+	if err := os.MkdirAll(filepath.Dir(configPath), 0755); err != nil {
+		t.Fatalf("Failed to create config dir: %v", err)
+	}
+
+	if err := os.WriteFile(configPath, []byte("notes_max_length = 500\n"), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	if !GetTypeIcons() {
+		t.Error("GetTypeIcons() with option not set = false, want true")
+	}
+
+	if err := os.WriteFile(configPath, []byte("type_icons = false\n"), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	if GetTypeIcons() {
+		t.Error("GetTypeIcons() with type_icons = false = true, want false")
+	}
+}
+
+// TestSessionState tests that the session state round-trips through state.toml,
+// and that a missing file resumes nothing
+func TestSessionState(t *testing.T) {
+	useTempHome(t)
+
+	state, err := LoadState()
+	if err != nil {
+		t.Fatalf("LoadState() with no file failed: %v", err)
+	}
+	if state != (SessionState{}) {
+		t.Errorf("LoadState() with no file = %+v, want empty state", state)
+	}
+
+	want := SessionState{Screen: StateScreenDetail, BookID: 42, Library: "/home/reader/.libros/books.db"}
+	if err := SaveState(want); err != nil {
+		t.Fatalf("SaveState() failed: %v", err)
+	}
+	state, err = LoadState()
+	if err != nil {
+		t.Fatalf("LoadState() failed: %v", err)
+	}
+	if state != want {
+		t.Errorf("LoadState() = %+v, want %+v", state, want)
+	}
+}
+
+// TestDraft tests that the add form draft round-trips through draft.toml,
+// and that saving an empty draft or clearing it removes the file
+func TestDraft(t *testing.T) {
+	useTempHome(t)
+
+	draft, err := LoadDraft()
+	if err != nil {
+		t.Fatalf("LoadDraft() with no file failed: %v", err)
+	}
+	if !draft.Empty() {
+		t.Errorf("LoadDraft() with no file = %+v, want empty draft", draft)
+	}
+
+	want := Draft{Title: "Dune", Author: "Frank Herbert", Type: models.Hardback, Location: "Shelf 2", Notes: "Line one\nLine two"}
+	if err := SaveDraft(want); err != nil {
+		t.Fatalf("SaveDraft() failed: %v", err)
+	}
+	draft, err = LoadDraft()
+	if err != nil {
+		t.Fatalf("LoadDraft() failed: %v", err)
+	}
+	if draft != want {
+		t.Errorf("LoadDraft() = %+v, want %+v", draft, want)
+	}
+
+	if err := SaveDraft(Draft{Type: models.Paperback, Notes: "  "}); err != nil {
+		t.Fatalf("SaveDraft(empty) failed: %v", err)
+	}
+	if draft, _ := LoadDraft(); !draft.Empty() {
+		t.Errorf("LoadDraft() after saving an empty draft = %+v, want empty draft", draft)
+	}
+
+	if err := SaveDraft(want); err != nil {
+		t.Fatalf("SaveDraft() failed: %v", err)
+	}
+	if err := ClearDraft(); err != nil {
+		t.Fatalf("ClearDraft() failed: %v", err)
+	}
+	if draft, _ := LoadDraft(); !draft.Empty() {
+		t.Errorf("LoadDraft() after ClearDraft() = %+v, want empty draft", draft)
+	}
+	if err := ClearDraft(); err != nil {
+		t.Errorf("ClearDraft() with no file failed: %v", err)
+	}
+}
+
+// TestGetResumeSession tests that resuming is on by default and can be turned off
+func TestGetResumeSession(t *testing.T) {
+	configPath := useTempHome(t)
+	if err := os.MkdirAll(filepath.Dir(configPath), 0755); err != nil {
+		t.Fatalf("Failed to create config dir: %v", err)
+	}
+
+	if err := os.WriteFile(configPath, []byte("notes_max_length = 500\n"), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	if !GetResumeSession() {
+		t.Error("GetResumeSession() with option not set = false, want true")
+	}
+
+	if err := os.WriteFile(configPath, []byte("resume_session = false\n"), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	if GetResumeSession() {
+		t.Error("GetResumeSession() with resume_session = false = true, want false")
+	}
+}
+
+// TestGetRememberType tests that remembering the last book type is on by default and can be turned off
+func TestGetRememberType(t *testing.T) {
+	configPath := useTempHome(t)
+	if err := os.MkdirAll(filepath.Dir(configPath), 0755); err != nil {
+		t.Fatalf("Failed to create config dir: %v", err)
+	}
+
+	if err := os.WriteFile(configPath, []byte("notes_max_length = 500\n"), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	if !GetRememberType() {
+		t.Error("GetRememberType() with option not set = false, want true")
+	}
+
+	if err := os.WriteFile(configPath, []byte("remember_type = false\n"), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	if GetRememberType() {
+		t.Error("GetRememberType() with remember_type = false = true, want false")
+	}
+}
+
+// TestGetFullWidth tests that the full-width styling is on by default and can be turned off
+func TestGetFullWidth(t *testing.T) {
+	configPath := useTempHome(t)
+	if err := os.MkdirAll(filepath.Dir(configPath), 0755); err != nil {
+		t.Fatalf("Failed to create config dir: %v", err)
+	}
+
+	if err := os.WriteFile(configPath, []byte("notes_max_length = 500\n"), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	if !GetFullWidth() {
+		t.Error("GetFullWidth() with option not set = false, want true")
+	}
+
+	if err := os.WriteFile(configPath, []byte("full_width = false\n"), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	if GetFullWidth() {
+		t.Error("GetFullWidth() with full_width = false = true, want false")
+	}
+}
+
+// TestCached tests that the render settings are read once and refreshed when the config is saved
+// Edits made to the file behind the app's back wait until the next save or start
+func TestCached(t *testing.T) {
+	configPath := useTempHome(t)
+	if err := os.MkdirAll(filepath.Dir(configPath), 0755); err != nil {
+		t.Fatalf("Failed to create config dir: %v", err)
+	}
+
+	if err := os.WriteFile(configPath, []byte("full_width = false\n"), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	LoadSettings()
+	if Cached().FullWidth {
+		t.Error("Cached().FullWidth after loading full_width = false = true, want false")
+	}
+
+	if err := os.WriteFile(configPath, []byte("full_width = true\n"), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	if Cached().FullWidth {
+		t.Error("Cached().FullWidth changed without a save or reload")
+	}
+
+	config := DefaultConfig()
+	config.FullWidth = true
+	if err := SaveConfig(config); err != nil {
+		t.Fatalf("SaveConfig() returned error: %v", err)
+	}
+	if !Cached().FullWidth {
+		t.Error("Cached().FullWidth after saving full_width = true = false, want true")
+	}
+}
+
+// TestGetPinHeader tests that the header is pinned by default and can be left to scroll
+func TestGetPinHeader(t *testing.T) {
+	configPath := useTempHome(t)
+	if err := os.MkdirAll(filepath.Dir(configPath), 0755); err != nil {
+		t.Fatalf("Failed to create config dir: %v", err)
+	}
+
+	if err := os.WriteFile(configPath, []byte("notes_max_length = 500\n"), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	if !GetPinHeader() {
+		t.Error("GetPinHeader() with option not set = false, want true")
+	}
+
+	if err := os.WriteFile(configPath, []byte("pin_header = false\n"), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	if GetPinHeader() {
+		t.Error("GetPinHeader() with pin_header = false = true, want false")
+	}
+}
+
+// TestExportExclude tests that the fields left out of JSON exports are saved
+// and that every field is exported by default
+func TestExportExclude(t *testing.T) {
+	useTempHome(t)
+
+	if fields := GetExportExclude(); len(fields) != 0 {
+		t.Errorf("GetExportExclude() with no config = %v, want none", fields)
+	}
+
+	if err := UpdateExportExclude([]string{"notes", "dates"}); err != nil {
+		t.Fatalf("UpdateExportExclude() failed: %v", err)
+	}
+	if fields := GetExportExclude(); strings.Join(fields, ",") != "notes,dates" {
+		t.Errorf("GetExportExclude() = %v, want [notes dates]", fields)
+	}
+
+	if err := UpdateExportExclude(nil); err != nil {
+		t.Fatalf("UpdateExportExclude(nil) failed: %v", err)
+	}
+	if fields := GetExportExclude(); len(fields) != 0 {
+		t.Errorf("GetExportExclude() after including every field = %v, want none", fields)
+	}
+}
+
+// TestUpdateExportExclude_KeepsCorruptConfig tests that a JSON export does not
+// replace a config file that fails to parse when it remembers the chosen fields
+func TestUpdateExportExclude_KeepsCorruptConfig(t *testing.T) {
+	configPath := writeCorruptConfig(t)
+
+	err := UpdateExportExclude([]string{"notes"})
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("UpdateExportExclude() with a corrupt config returned %v, want a *ParseError", err)
+	}
+	checkConfigUnchanged(t, configPath)
+}
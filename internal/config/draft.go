@@ -0,0 +1,95 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/papadavis47/libros/internal/models"
+)
+
+// Draft holds the fields of a book being typed on the add form, so they survive
+// a crash or quitting before the book is saved
+// It is kept in ~/.libros/draft.toml and removed once the book is saved or the draft discarded
+type Draft struct {
+	Title    string          `toml:"title,omitempty"`
+	Author   string          `toml:"author,omitempty"`
+	Type     models.BookType `toml:"type,omitempty"`
+	Location string          `toml:"location,omitempty"`
+	Notes    string          `toml:"notes,omitempty"`
+}
+
+// Empty reports whether the draft has nothing worth restoring
+// The type alone is not kept, since every form starts with one selected
+func (d Draft) Empty() bool {
+	return strings.TrimSpace(d.Title) == "" && strings.TrimSpace(d.Author) == "" &&
+		strings.TrimSpace(d.Location) == "" && strings.TrimSpace(d.Notes) == ""
+}
+
+// LoadDraft loads the unsaved add form draft from the user's ~/.libros directory
+// A missing file is not an error and returns an empty draft
+func LoadDraft() (Draft, error) {
+	var draft Draft
+	draftPath, err := getDraftPath()
+	if err != nil {
+		return draft, err
+	}
+
+	if _, err := os.Stat(draftPath); os.IsNotExist(err) {
+		return draft, nil
+	}
+
+	if _, err := toml.DecodeFile(draftPath, &draft); err != nil {
+		return Draft{}, &ParseError{Path: draftPath, Err: err}
+	}
+	return draft, nil
+}
+
+// SaveDraft saves the add form draft to the user's ~/.libros directory
+// An empty draft removes the file instead, as there is nothing to restore
+func SaveDraft(draft Draft) error {
+	if draft.Empty() {
+		return ClearDraft()
+	}
+
+	draftPath, err := getDraftPath()
+	if err != nil {
+		return err
+	}
+
+	if err := ensureConfigDir(); err != nil {
+		return err
+	}
+
+	file, err := os.Create(draftPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return toml.NewEncoder(file).Encode(draft)
+}
+
+// ClearDraft removes the add form draft; a missing file is not an error
+func ClearDraft() error {
+	draftPath, err := getDraftPath()
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(draftPath); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// getDraftPath returns the path to the add form draft file
+func getDraftPath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(homeDir, ".libros", "draft.toml"), nil
+}
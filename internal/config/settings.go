@@ -0,0 +1,397 @@
+package config
+
+import (
+	"strings"
+	"time"
+
+	"github.com/papadavis47/libros/internal/constants"
+	"github.com/papadavis47/libros/internal/models"
+)
+
+// Runtime settings accessors
+// These read the current configuration and fall back to safe defaults, so callers
+// always receive a usable value even when the config file is missing or incomplete
+
+// GetNotesMaxLength returns the configured maximum length for book notes
+func GetNotesMaxLength() int {
+	config, err := LoadConfig()
+	if err != nil {
+		return constants.NotesMaxLength
+	}
+	return normalizeNotesMaxLength(config.NotesMaxLength)
+}
+
+// normalizeNotesMaxLength keeps a configured notes limit within sensible bounds
+// Unset or negative values use the default, and very large values are capped
+func normalizeNotesMaxLength(length int) int {
+	if length <= 0 {
+		return constants.NotesMaxLength
+	}
+	if length > constants.NotesMaxLengthLimit {
+		return constants.NotesMaxLengthLimit
+	}
+	return length
+}
+
+// GetNotesHeight returns the configured height of the notes textarea in lines
+func GetNotesHeight() int {
+	config, err := LoadConfig()
+	if err != nil {
+		return constants.NotesHeight
+	}
+	return normalizeNotesHeight(config.NotesHeight)
+}
+
+// normalizeNotesHeight keeps a configured notes height within the allowed range
+// Unset values use the default
+func normalizeNotesHeight(height int) int {
+	if height == 0 {
+		return constants.NotesHeight
+	}
+	return max(constants.NotesMinHeight, min(height, constants.NotesMaxHeight))
+}
+
+// GetListNotesLength returns how many characters of notes each book list card shows
+func GetListNotesLength() int {
+	config, err := LoadConfig()
+	if err != nil {
+		return constants.ListNotesLength
+	}
+	return normalizeListNotesLength(config.ListNotesLength)
+}
+
+// normalizeListNotesLength keeps a configured list notes length readable
+// Unset values use the default, and very short values are raised to the minimum
+func normalizeListNotesLength(length int) int {
+	if length == 0 {
+		return constants.ListNotesLength
+	}
+	return max(length, constants.ListNotesMinLength)
+}
+
+// GetMessageTimeout returns how long success messages such as "Book saved successfully!"
+// stay on screen; zero means they stay until the user moves on
+func GetMessageTimeout() time.Duration {
+	config, err := LoadConfig()
+	if err != nil {
+		return 0
+	}
+	return time.Duration(normalizeMessageTimeout(config.MessageTimeout)) * time.Second
+}
+
+// normalizeMessageTimeout keeps a configured message timeout, in seconds, within bounds
+// Unset or negative values never hide messages, and very long delays are capped
+func normalizeMessageTimeout(seconds int) int {
+	return max(0, min(seconds, constants.MessageTimeoutMax))
+}
+
+// Main menu entry names accepted in the menu setting
+const (
+	MenuAdd       = "add"
+	MenuView      = "view"
+	MenuUtilities = "utilities"
+	MenuTheme     = "theme"
+	MenuQuit      = "quit"
+)
+
+// DefaultMenu lists every main menu entry in its default order
+var DefaultMenu = []string{MenuAdd, MenuView, MenuUtilities, MenuTheme, MenuQuit}
+
+// GetMenu returns the main menu entries to show, in order
+// A missing setting or unreadable config returns the default menu
+func GetMenu() []string {
+	config, err := LoadConfig()
+	if err != nil {
+		return DefaultMenu
+	}
+	return normalizeMenu(config.Menu)
+}
+
+// normalizeMenu drops unknown and repeated entries from a configured menu
+// An unset menu uses the default, and quit is always kept so the app can be left
+func normalizeMenu(entries []string) []string {
+	if len(entries) == 0 {
+		return DefaultMenu
+	}
+	known := make(map[string]bool, len(DefaultMenu))
+	for _, name := range DefaultMenu {
+		known[name] = true
+	}
+
+	menu := make([]string, 0, len(entries)+1)
+	for _, entry := range entries {
+		name := strings.ToLower(strings.TrimSpace(entry))
+		if !known[name] {
+			continue
+		}
+		known[name] = false // Only the first occurrence is used
+		menu = append(menu, name)
+	}
+	if known[MenuQuit] {
+		menu = append(menu, MenuQuit)
+	}
+	return menu
+}
+
+// GetAttention returns the names of the checks the Needs Attention report runs, in order
+// A missing setting or unreadable config runs every check
+func GetAttention() []string {
+	config, err := LoadConfig()
+	if err != nil {
+		return attentionNames()
+	}
+	return normalizeAttention(config.Attention)
+}
+
+// normalizeAttention drops unknown and repeated checks from a configured list
+// An unset list, or one naming no known check, runs every check
+func normalizeAttention(names []string) []string {
+	known := make(map[string]bool, len(models.AttentionChecks))
+	for _, name := range attentionNames() {
+		known[name] = true
+	}
+
+	var checks []string
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !known[name] {
+			continue
+		}
+		known[name] = false // Only the first occurrence is used
+		checks = append(checks, name)
+	}
+	if len(checks) == 0 {
+		return attentionNames()
+	}
+	return checks
+}
+
+// attentionNames lists every Needs Attention check in its default order
+func attentionNames() []string {
+	names := make([]string, len(models.AttentionChecks))
+	for i, check := range models.AttentionChecks {
+		names[i] = check.Name
+	}
+	return names
+}
+
+// GetKeybindings returns the key binding overrides from the [keybindings] section
+// A missing section or unreadable config returns nil, leaving the default keys in place
+func GetKeybindings() map[string][]string {
+	config, err := LoadConfig()
+	if err != nil {
+		return nil
+	}
+	return config.Keybindings
+}
+
+// GetHelp returns the help line overrides from the [help] section
+// A missing section or unreadable config returns nil, leaving the default help in place
+func GetHelp() map[string]string {
+	config, err := LoadConfig()
+	if err != nil {
+		return nil
+	}
+	return config.Help
+}
+
+// DefaultLanguage is the language used when none is configured
+const DefaultLanguage = "en"
+
+// GetLanguage returns the configured interface language as a lowercase language code
+// A missing setting or unreadable config returns the default language
+func GetLanguage() string {
+	config, err := LoadConfig()
+	if err != nil {
+		return DefaultLanguage
+	}
+	return normalizeLanguage(config.Language)
+}
+
+// normalizeLanguage reduces a locale such as "es_MX.UTF-8" or "es-MX" to its language code
+// An unset language uses the default
+func normalizeLanguage(language string) string {
+	language = strings.ToLower(strings.TrimSpace(language))
+	if i := strings.IndexAny(language, "_-."); i >= 0 {
+		language = language[:i]
+	}
+	if language == "" {
+		return DefaultLanguage
+	}
+	return language
+}
+
+// Color profile settings
+const (
+	ColorProfileAuto      = "auto"      // Detect the terminal's color support
+	ColorProfileTrueColor = "truecolor" // 24-bit color
+	ColorProfile256       = "256"       // The 256 color ANSI palette
+	ColorProfile16        = "16"        // The 16 basic ANSI colors
+	ColorProfileNone      = "none"      // No colors or text attributes
+)
+
+// GetColorProfile returns the configured color profile setting
+// A missing setting or unreadable config detects the terminal's support
+func GetColorProfile() string {
+	config, err := LoadConfig()
+	if err != nil {
+		return ColorProfileAuto
+	}
+	return normalizeColorProfile(config.ColorProfile)
+}
+
+// normalizeColorProfile validates a configured color profile, ignoring case
+// Unset or unknown values detect the terminal's support
+func normalizeColorProfile(profile string) string {
+	profile = strings.ToLower(strings.TrimSpace(profile))
+	switch profile {
+	case ColorProfileTrueColor, ColorProfile256, ColorProfile16, ColorProfileNone:
+		return profile
+	}
+	return ColorProfileAuto
+}
+
+// GetBackupOverwrite reports whether a database backup may replace an existing backup file
+// An unreadable config keeps the original behavior of overwriting
+func GetBackupOverwrite() bool {
+	config, err := LoadConfig()
+	if err != nil {
+		return true
+	}
+	return config.BackupOverwrite
+}
+
+// GetConfirmBeforeSave reports whether the add form shows a review step before saving
+// An unreadable config saves straight away, as the form always has
+func GetConfirmBeforeSave() bool {
+	config, err := LoadConfig()
+	if err != nil {
+		return false
+	}
+	return config.ConfirmBeforeSave
+}
+
+// GetNotesTemplate returns the text the add form's notes field is pre-filled with
+// An unset option or unreadable config leaves the notes empty
+func GetNotesTemplate() string {
+	config, err := LoadConfig()
+	if err != nil {
+		return ""
+	}
+	return config.NotesTemplate
+}
+
+// GetTypeIcons reports whether book types are shown with an emoji icon
+// An unreadable config keeps the icons, matching the default
+func GetTypeIcons() bool {
+	config, err := LoadConfig()
+	if err != nil {
+		return true
+	}
+	return config.TypeIcons
+}
+
+// GetFullWidth reports whether titles and menus use full-width glyphs and text is letter-spaced
+// An unreadable config keeps the full-width styling, matching the default
+func GetFullWidth() bool {
+	config, err := LoadConfig()
+	if err != nil {
+		return true
+	}
+	return config.FullWidth
+}
+
+// GetPinHeader reports whether long screens keep their title in place while the rest scrolls
+// An unreadable config pins the header, matching the default
+func GetPinHeader() bool {
+	config, err := LoadConfig()
+	if err != nil {
+		return true
+	}
+	return config.PinHeader
+}
+
+// GetResumeSession reports whether startup reopens the screen the last session ended on
+// An unreadable config resumes, matching the default
+func GetResumeSession() bool {
+	config, err := LoadConfig()
+	if err != nil {
+		return true
+	}
+	return config.ResumeSession
+}
+
+// GetRememberType reports whether the add form reopens on the type of the last book saved,
+// rather than the default book type
+// An unreadable config remembers the type, matching the default
+func GetRememberType() bool {
+	config, err := LoadConfig()
+	if err != nil {
+		return true
+	}
+	return config.RememberType
+}
+
+// GetExportExclude returns the optional fields the last JSON export left out
+// A missing setting or unreadable config returns nil, so every field is exported
+func GetExportExclude() []string {
+	config, err := LoadConfig()
+	if err != nil {
+		return nil
+	}
+	return config.ExportExclude
+}
+
+// GetDefaultBookType returns the book type preselected on the add form
+// Unknown types and an unreadable config fall back to paperback
+func GetDefaultBookType() models.BookType {
+	config, err := LoadConfig()
+	if err != nil {
+		return models.Paperback
+	}
+	if bookType, ok := models.ParseBookType(config.DefaultBookType); ok {
+		return bookType
+	}
+	return models.Paperback
+}
+
+// Book list sort fields and directions accepted in the default_sort settings
+const (
+	SortAdded  = "added"
+	SortTitle  = "title"
+	SortAuthor = "author"
+
+	SortAsc  = "asc"
+	SortDesc = "desc"
+)
+
+// SortFields lists the book list sort fields in the order they are cycled through
+var SortFields = []string{SortAdded, SortTitle, SortAuthor}
+
+// GetSort returns the book list sort field and direction
+// A missing or invalid setting, or an unreadable config, sorts newest first
+func GetSort() (field, dir string) {
+	config, err := LoadConfig()
+	if err != nil {
+		return SortAdded, SortDesc
+	}
+	return normalizeSort(config.DefaultSort, config.DefaultSortDir)
+}
+
+// normalizeSort validates a configured sort, falling back to newest first
+// An unknown field resets the direction too, since it was chosen for that field
+func normalizeSort(field, dir string) (string, string) {
+	field = strings.ToLower(strings.TrimSpace(field))
+	dir = strings.ToLower(strings.TrimSpace(dir))
+	known := false
+	for _, name := range SortFields {
+		known = known || name == field
+	}
+	if !known {
+		return SortAdded, SortDesc
+	}
+	if dir != SortAsc && dir != SortDesc {
+		dir = SortDesc
+	}
+	return field, dir
+}
@@ -0,0 +1,186 @@
+package config
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/papadavis47/libros/internal/constants"
+)
+
+// TestNormalizeNotesMaxLength tests that configured notes limits are kept within bounds
+// Missing values fall back to the default and oversized values are capped
+func TestNormalizeNotesMaxLength(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    int
+		expected int
+	}{
+		{"unset uses default", 0, constants.NotesMaxLength},
+		{"negative uses default", -50, constants.NotesMaxLength},
+		{"custom value kept", 5000, 5000},
+		{"smaller than default kept", 200, 200},
+		{"limit kept", constants.NotesMaxLengthLimit, constants.NotesMaxLengthLimit},
+		{"oversized value capped", 10000000, constants.NotesMaxLengthLimit},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := normalizeNotesMaxLength(tt.input); got != tt.expected {
+				t.Errorf("normalizeNotesMaxLength(%d) = %d, want %d", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestNormalizeListNotesLength tests that the list notes length has a sensible minimum
+// A missing value falls back to the default and short values are raised
+func TestNormalizeListNotesLength(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    int
+		expected int
+	}{
+		{"unset uses default", 0, constants.ListNotesLength},
+		{"negative raised to minimum", -10, constants.ListNotesMinLength},
+		{"short value raised to minimum", 5, constants.ListNotesMinLength},
+		{"minimum kept", constants.ListNotesMinLength, constants.ListNotesMinLength},
+		{"custom value kept", 120, 120},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := normalizeListNotesLength(tt.input); got != tt.expected {
+				t.Errorf("normalizeListNotesLength(%d) = %d, want %d", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestNormalizeMessageTimeout tests that the success message timeout stays within bounds
+// Zero and negative values keep messages until navigation, and long delays are capped
+func TestNormalizeMessageTimeout(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    int
+		expected int
+	}{
+		{"unset never hides", 0, 0},
+		{"negative never hides", -5, 0},
+		{"custom value kept", 3, 3},
+		{"maximum kept", constants.MessageTimeoutMax, constants.MessageTimeoutMax},
+		{"long delay capped", constants.MessageTimeoutMax + 1, constants.MessageTimeoutMax},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := normalizeMessageTimeout(tt.input); got != tt.expected {
+				t.Errorf("normalizeMessageTimeout(%d) = %d, want %d", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestNormalizeLanguage tests reducing configured locales to a language code
+func TestNormalizeLanguage(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"unset uses default", "", DefaultLanguage},
+		{"blank uses default", "  ", DefaultLanguage},
+		{"code kept", "es", "es"},
+		{"uppercase lowered", "ES", "es"},
+		{"region dropped", "es-MX", "es"},
+		{"locale reduced", "es_ES.UTF-8", "es"},
+		{"spaces trimmed", " en ", "en"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := normalizeLanguage(tt.input); got != tt.expected {
+				t.Errorf("normalizeLanguage(%q) = %q, want %q", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestNormalizeColorProfile tests that a configured color profile is validated
+// Unknown values fall back to detecting the terminal's support
+func TestNormalizeColorProfile(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"unset detects", "", ColorProfileAuto},
+		{"auto kept", "auto", ColorProfileAuto},
+		{"truecolor kept", "truecolor", ColorProfileTrueColor},
+		{"256 kept", "256", ColorProfile256},
+		{"16 kept", "16", ColorProfile16},
+		{"none kept", "none", ColorProfileNone},
+		{"uppercase lowered", "NONE", ColorProfileNone},
+		{"spaces trimmed", " 16 ", ColorProfile16},
+		{"unknown detects", "8", ColorProfileAuto},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := normalizeColorProfile(tt.input); got != tt.expected {
+				t.Errorf("normalizeColorProfile(%q) = %q, want %q", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestNormalizeAttention tests that configured Needs Attention checks keep known names in order
+// Unknown and repeated names are dropped, and an empty result runs every check
+func TestNormalizeAttention(t *testing.T) {
+	all := []string{"notes", "location", "author"}
+	tests := []struct {
+		name     string
+		input    []string
+		expected []string
+	}{
+		{"unset runs every check", nil, all},
+		{"reordered", []string{"author", "notes"}, []string{"author", "notes"}},
+		{"unknown names ignored", []string{"rating", "location", "isbn"}, []string{"location"}},
+		{"repeats dropped", []string{"notes", "author", "notes"}, []string{"notes", "author"}},
+		{"case and spaces ignored", []string{" Notes ", "AUTHOR"}, []string{"notes", "author"}},
+		{"only unknown names", []string{"rating"}, all},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := normalizeAttention(tt.input); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("normalizeAttention(%v) = %v, want %v", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestNormalizeMenu tests that a configured menu keeps known entries in order
+// Unknown and repeated entries are dropped, and quit is always present
+func TestNormalizeMenu(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []string
+		expected []string
+	}{
+		{"unset uses default", nil, DefaultMenu},
+		{"reordered", []string{"view", "add", "quit", "utilities"}, []string{"view", "add", "quit", "utilities"}},
+		{"hidden entries and quit appended", []string{"add", "view"}, []string{"add", "view", "quit"}},
+		{"unknown entries ignored", []string{"add", "stats", "theme"}, []string{"add", "theme", "quit"}},
+		{"repeats dropped", []string{"theme", "add", "theme"}, []string{"theme", "add", "quit"}},
+		{"case and spaces ignored", []string{" Add ", "QUIT"}, []string{"add", "quit"}},
+		{"only unknown entries", []string{"stats"}, []string{"quit"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := normalizeMenu(tt.input); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("normalizeMenu(%v) = %v, want %v", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
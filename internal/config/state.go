@@ -0,0 +1,72 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/BurntSushi/toml"
+)
+
+// Screens a session can be resumed on
+// Any other screen is remembered as the main menu
+const (
+	StateScreenList   = "list"
+	StateScreenDetail = "detail"
+)
+
+// SessionState records where the user was when Libros last closed
+// It is kept in ~/.libros/state.toml, apart from the user's settings
+type SessionState struct {
+	Screen  string `toml:"screen"`            // StateScreenList, StateScreenDetail or empty for the menu
+	BookID  int    `toml:"book_id,omitempty"` // Book shown on the detail screen
+	Library string `toml:"library,omitempty"` // Database file the session was using
+}
+
+// LoadState loads the last session state from the user's ~/.libros directory
+// A missing file is not an error and returns an empty state
+func LoadState() (SessionState, error) {
+	var state SessionState
+	statePath, err := getStatePath()
+	if err != nil {
+		return state, err
+	}
+
+	if _, err := os.Stat(statePath); os.IsNotExist(err) {
+		return state, nil
+	}
+
+	if _, err := toml.DecodeFile(statePath, &state); err != nil {
+		return SessionState{}, &ParseError{Path: statePath, Err: err}
+	}
+	return state, nil
+}
+
+// SaveState saves the session state to the user's ~/.libros directory
+func SaveState(state SessionState) error {
+	statePath, err := getStatePath()
+	if err != nil {
+		return err
+	}
+
+	if err := ensureConfigDir(); err != nil {
+		return err
+	}
+
+	file, err := os.Create(statePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return toml.NewEncoder(file).Encode(state)
+}
+
+// getStatePath returns the path to the session state file
+func getStatePath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(homeDir, ".libros", "state.toml"), nil
+}
@@ -8,13 +8,28 @@ type Theme struct {
 	PrimaryColor   string `toml:"primary_color"`
 	SecondaryColor string `toml:"secondary_color"`
 	TertiaryColor  string `toml:"tertiary_color"`
+	// SelectedTextColor is drawn on top of PrimaryColor for selected items
+	// Empty means white, which suits the darker built-in primaries
+	SelectedTextColor string `toml:"selected_text_color,omitempty"`
+}
+
+// defaultSelectedTextColor is the text color on selected items when a theme does not set one
+const defaultSelectedTextColor = "#FFFFFF"
+
+// SelectedText returns the text color to draw on the theme's primary color
+func (t Theme) SelectedText() string {
+	if t.SelectedTextColor == "" {
+		return defaultSelectedTextColor
+	}
+	return t.SelectedTextColor
 }
 
 // ThemeOption represents a theme option for selection
 type ThemeOption struct {
-	Name  string
-	Value string
-	Color string
+	Name      string
+	Value     string
+	Color     string
+	TextColor string // Text color on the selected option, matching Theme.SelectedText
 }
 
 // Available themes
@@ -51,6 +66,26 @@ var (
 		SecondaryColor: "#f8a5c2",
 		TertiaryColor:  "#f78fb3",
 	}
+
+	// HighContrastTheme pairs bright yellow and cyan with black text on
+	// selected items for the strongest contrast on dark terminals
+	HighContrastTheme = Theme{
+		Name:              "High Contrast",
+		PrimaryColor:      "#FFFF00",
+		SecondaryColor:    "#00FFFF",
+		TertiaryColor:     "#FFFFFF",
+		SelectedTextColor: "#000000",
+	}
+
+	// ColorBlindTheme uses the Okabe-Ito palette, whose blue, orange and sky blue
+	// stay distinct under the common forms of color blindness
+	ColorBlindTheme = Theme{
+		Name:              "Color Blind Safe",
+		PrimaryColor:      "#0072B2",
+		SecondaryColor:    "#E69F00",
+		TertiaryColor:     "#56B4E9",
+		SelectedTextColor: "#FFFFFF",
+	}
 )
 
 // AllThemes returns all available themes
@@ -60,6 +95,8 @@ func AllThemes() []Theme {
 		PeachRedTheme,
 		SurimiOrangeTheme,
 		SpringBlueTheme,
+		HighContrastTheme,
+		ColorBlindTheme,
 	}
 }
 
@@ -70,6 +107,8 @@ func ThemeOptions() []ThemeOption {
 		{Name: "Peach Red", Value: "peach_red", Color: "#ff5d62"},
 		{Name: "Surimi Orange", Value: "surimi_orange", Color: "#ff9e3b"},
 		{Name: "Spring Blue", Value: "spring_blue", Color: "#7fb4ca"},
+		{Name: "High Contrast", Value: "high_contrast", Color: "#FFFF00", TextColor: "#000000"},
+		{Name: "Color Blind Safe", Value: "color_blind", Color: "#0072B2", TextColor: "#FFFFFF"},
 	}
 }
 
@@ -84,6 +123,10 @@ func GetThemeByValue(value string) Theme {
 		return SurimiOrangeTheme
 	case "spring_blue":
 		return SpringBlueTheme
+	case "high_contrast":
+		return HighContrastTheme
+	case "color_blind":
+		return ColorBlindTheme
 	default:
 		return DefaultTheme
 	}
@@ -108,5 +151,5 @@ func CreateThemedStyle(theme Theme) lipgloss.Style {
 func CreateThemedBackgroundStyle(theme Theme) lipgloss.Style {
 	return lipgloss.NewStyle().
 		Background(lipgloss.Color(theme.PrimaryColor)).
-		Foreground(lipgloss.Color("#FFFFFF"))
+		Foreground(lipgloss.Color(theme.SelectedText()))
 }
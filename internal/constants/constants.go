@@ -9,14 +9,54 @@ const (
 	TextAreaWidth       = 60
 	TitleMaxLength      = 255
 	AuthorMaxLength     = 255 
+	LocationMaxLength   = 100
+	BorrowerMaxLength   = 100
 	NotesMaxLength      = 1000
+	NotesMaxLengthLimit = 20000 // Upper bound for a user-configured notes limit
+	NotesHeight         = 4     // Default lines shown by the notes textarea on add/edit
+	NotesMinHeight      = 2     // Smallest notes textarea height
+	NotesMaxHeight      = 20    // Largest notes textarea height
 	
 	// List and pagination
 	BooksPerPage        = 3
+	ListChromeHeight    = 14  // Lines used around the book list by headers, counts and help
+	TwoColumnMinWidth   = 200 // Terminal width from which the book list shows two columns of cards
 	
 	// Text wrapping and truncation
 	TextWrapWidth       = 60
 	NoteTruncateLength  = 100
+	ListNotesLength     = 60 // Default characters of notes shown on each book list card
+	ListNotesMinLength  = 20 // Shortest configurable list note preview
+
+	// Detail screen notes scrolling
+	NotesViewportHeight    = 10 // Notes height used before the terminal size is known
+	NotesViewportMinHeight = 5  // Smallest scrollable notes area on short terminals
+	DetailChromeHeight     = 30 // Lines used by the rest of the detail screen
+
+	// Pinned header scrolling
+	PinnedBodyMinHeight = 3 // Smallest scrolling area below a pinned header on short terminals
+
+	// Needs Attention report
+	AttentionListHeight = 10 // Books shown at once when listing the books a check found
+
+	// Book edit history
+	HistoryMaxVersions = 20 // Earlier versions kept per book; older ones are dropped
+
+	// Statistics chart
+	StatsBarWidth = 30 // Length of the bar for the year with the most books added
+
+	// Success messages
+	MessageTimeoutMax = 3600 // Longest configurable delay, in seconds, before a success message is hidden
+
+	// Add form draft
+	DraftSaveDelay = 1000 // Milliseconds of no typing before the add form's draft is saved
+
+	// Quick switcher (ctrl+p)
+	PaletteMaxResults = 10 // Matching books shown at once
+
+	// Bulk add form
+	BulkEntryHeight    = 10    // Lines shown by the bulk entry textarea
+	BulkEntryMaxLength = 50000 // Characters that can be pasted into the bulk entry
 	
 	// File permissions
 	DirPermissions      = 0755
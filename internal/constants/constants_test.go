@@ -145,6 +145,12 @@ func TestConstants_Logical_Relationships(t *testing.T) {
 		t.Errorf("NoteTruncateLength (%d) should be less than NotesMaxLength (%d)", 
 			NoteTruncateLength, NotesMaxLength)
 	}
+
+	// The default list note preview must not be below the configurable minimum
+	if ListNotesMinLength > ListNotesLength {
+		t.Errorf("ListNotesMinLength (%d) should not exceed ListNotesLength (%d)",
+			ListNotesMinLength, ListNotesLength)
+	}
 	
 	// BooksPerPage should be a reasonable number (not too high or too low)
 	if BooksPerPage < 1 || BooksPerPage > 10 {
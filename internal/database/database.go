@@ -5,15 +5,23 @@ package database
 import (
 	"database/sql"
 	"fmt"
+	"os"
 	"strings"
+	"time"
+	"unicode"
 
 	_ "github.com/mattn/go-sqlite3" // SQLite driver for database/sql
+	"github.com/papadavis47/libros/internal/constants"
 	"github.com/papadavis47/libros/internal/models"
 )
 
+// timestampFormat matches the layout SQLite uses for CURRENT_TIMESTAMP values
+const timestampFormat = "2006-01-02 15:04:05"
+
 // DB wraps a SQL database connection and provides methods for book management operations.
 type DB struct {
 	conn *sql.DB // SQLite database connection
+	path string  // Path to the SQLite database file
 }
 
 // New creates a new database connection and initializes the books table.
@@ -25,15 +33,45 @@ func New(dbPath string) (*DB, error) {
 		return nil, err
 	}
 
+	// Bubble Tea commands run in their own goroutines, so several queries can
+	// arrive at once. SQLite allows only one writer, so share a single
+	// connection and let database/sql queue callers instead of failing with
+	// "database is locked".
+	conn.SetMaxOpenConns(1)
+
 	// Create DB instance and initialize table schema
-	db := &DB{conn: conn}
+	db := &DB{conn: conn, path: dbPath}
 	if err := db.createTable(); err != nil {
+		conn.Close()
 		return nil, err
 	}
 
 	return db, nil
 }
 
+// OpenErrorHint explains the likely cause of an error returned by New and how to recover.
+// Errors that are not recognized get general advice covering the common causes.
+func OpenErrorHint(err error) string {
+	msg := strings.ToLower(err.Error())
+	switch {
+	case strings.Contains(msg, "permission denied"), strings.Contains(msg, "readonly"), strings.Contains(msg, "read-only"):
+		return "Libros does not have permission to write the database file or its folder. Check the ownership and permissions of ~/.libros and books.db."
+	case strings.Contains(msg, "disk is full"), strings.Contains(msg, "no space left"):
+		return "The disk is full. Free up some space and start Libros again."
+	case strings.Contains(msg, "not a database"), strings.Contains(msg, "malformed"):
+		return "The database file appears to be corrupt. Move books.db aside and restore books.db.bak from Database Backup, or start Libros again to create an empty collection."
+	case strings.Contains(msg, "unable to open database file"):
+		return "The database file could not be opened. Check that ~/.libros exists and is a folder you can write to."
+	default:
+		return "Check that ~/.libros is writable, that the disk has free space, and that books.db is not corrupt. A copy made with Database Backup is kept at ~/.libros/books.db.bak."
+	}
+}
+
+// Path returns the file path the database was opened from.
+func (db *DB) Path() string {
+	return db.path
+}
+
 // Close closes the database connection and releases resources.
 func (db *DB) Close() error {
 	return db.conn.Close()
@@ -50,6 +88,12 @@ func (db *DB) createTable() error {
 		author TEXT NOT NULL,
 		type TEXT NOT NULL DEFAULT 'paperback',
 		notes TEXT,
+		location TEXT NOT NULL DEFAULT '',
+		pinned INTEGER NOT NULL DEFAULT 0,
+		reading INTEGER NOT NULL DEFAULT 0,
+		owned INTEGER NOT NULL DEFAULT 1,
+		lent_to TEXT NOT NULL DEFAULT '',
+		lent_date DATETIME,
 		created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
 		updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
 	);`
@@ -60,61 +104,220 @@ func (db *DB) createTable() error {
 		return err
 	}
 
-	// Handle schema migration: add type column to existing tables
-	// This ensures backward compatibility with databases created before the type column was added
-	_, err = db.conn.Exec("ALTER TABLE books ADD COLUMN type TEXT NOT NULL DEFAULT 'paperback'")
-	if err != nil && !strings.Contains(err.Error(), "duplicate column name") {
+	// Bring older databases up to date, recording each migration in user_version
+	// (the schema version slot SQLite reserves for applications) so it runs only once
+	var version int
+	if err := db.conn.QueryRow("PRAGMA user_version").Scan(&version); err != nil {
 		return err
 	}
+	for i, migration := range migrations {
+		next := i + 2 // Version 1 is the original books table
+		if next <= version {
+			continue
+		}
+		// Databases from before versioning may already have the column, as does
+		// a new database, whose books table is created with every column
+		_, err = db.conn.Exec(migration)
+		if err != nil && !strings.Contains(err.Error(), "duplicate column name") {
+			return err
+		}
+		if _, err := db.conn.Exec(fmt.Sprintf("PRAGMA user_version = %d", next)); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
 
+// SchemaVersion is the version recorded in user_version once every migration has run
+const SchemaVersion = 9
+
+// migrations upgrade the schema one version at a time, in order; migration i
+// moves a database to version i+2. Add new migrations to the end and bump SchemaVersion.
+var migrations = []string{
+	// Add type column, for databases created before it was added
+	"ALTER TABLE books ADD COLUMN type TEXT NOT NULL DEFAULT 'paperback'",
+	// Add location column for shelf/storage notes
+	"ALTER TABLE books ADD COLUMN location TEXT NOT NULL DEFAULT ''",
+	// Add pinned flag for books kept at the top of the list
+	"ALTER TABLE books ADD COLUMN pinned INTEGER NOT NULL DEFAULT 0",
+	// Add flag for the book currently being read
+	"ALTER TABLE books ADD COLUMN reading INTEGER NOT NULL DEFAULT 0",
+	// Add owned flag; existing books are owned, not wishlisted
+	"ALTER TABLE books ADD COLUMN owned INTEGER NOT NULL DEFAULT 1",
+	// Add who a book is lent to and since when
+	"ALTER TABLE books ADD COLUMN lent_to TEXT NOT NULL DEFAULT ''",
+	"ALTER TABLE books ADD COLUMN lent_date DATETIME",
+	// Earlier versions of edited books, kept so an edit can be undone
+	`CREATE TABLE IF NOT EXISTS book_history (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		book_id INTEGER NOT NULL,
+		title TEXT NOT NULL,
+		author TEXT NOT NULL,
+		type TEXT NOT NULL,
+		notes TEXT NOT NULL DEFAULT '',
+		location TEXT NOT NULL DEFAULT '',
+		changed_at DATETIME DEFAULT CURRENT_TIMESTAMP
+	);`,
+}
+
+// hasControlChars reports whether text contains a control character (a tab, NUL,
+// the escape of an ANSI sequence, ...), which would corrupt the terminal display
+func hasControlChars(text string) bool {
+	return strings.IndexFunc(text, unicode.IsControl) >= 0
+}
+
 // SaveBook inserts a new book record into the database.
 // It validates required fields and trims whitespace from input values.
-func (db *DB) SaveBook(title, author string, bookType models.BookType, notes string) error {
+func (db *DB) SaveBook(title, author string, bookType models.BookType, notes, location string) error {
 	// Sanitize input by trimming whitespace
 	title = strings.TrimSpace(title)
 	author = strings.TrimSpace(author)
 	notes = strings.TrimSpace(notes)
+	location = strings.TrimSpace(location)
 
 	// Validate required fields
 	if title == "" || author == "" {
 		return fmt.Errorf("title, author, and type are required")
 	}
+	if hasControlChars(title) || hasControlChars(author) {
+		return fmt.Errorf("title and author must not contain control characters such as tabs or escape codes")
+	}
 
 	// Insert book record using parameterized query to prevent SQL injection
-	_, err := db.conn.Exec("INSERT INTO books (title, author, type, notes) VALUES (?, ?, ?, ?)", title, author, string(bookType), notes)
+	_, err := db.conn.Exec("INSERT INTO books (title, author, type, notes, location) VALUES (?, ?, ?, ?, ?)", title, author, string(bookType), notes, location)
 	return err
 }
 
-// LoadBooks retrieves all books from the database ordered by creation date (newest first).
+// ImportBooks inserts several books in a single transaction and returns how many were added.
+// Titles, authors, notes and locations are trimmed; if any insert fails, none of the books are added.
+// The pinned, reading and lent details and a non-zero creation date are kept, so a restored export
+// looks as it did; books without a creation date are dated now. An imported book being read
+// takes over from the one currently being read.
+func (db *DB) ImportBooks(books []models.Book) (int, error) {
+	tx, err := db.conn.Begin()
+	if err != nil {
+		return 0, err
+	}
+
+	for _, book := range books {
+		title := strings.TrimSpace(book.Title)
+		author := strings.TrimSpace(book.Author)
+		if title == "" || author == "" {
+			tx.Rollback()
+			return 0, fmt.Errorf("title and author are required")
+		}
+		if hasControlChars(title) || hasControlChars(author) {
+			tx.Rollback()
+			return 0, fmt.Errorf("title and author must not contain control characters such as tabs or escape codes")
+		}
+		lentTo := strings.TrimSpace(book.LentTo)
+		if hasControlChars(lentTo) {
+			tx.Rollback()
+			return 0, fmt.Errorf("borrower name must not contain control characters such as tabs or escape codes")
+		}
+
+		// created_at and lent_date are stored as UTC text, so nil falls back to the current time
+		var createdAt, lentDate interface{}
+		if !book.CreatedAt.IsZero() {
+			createdAt = book.CreatedAt.UTC().Format(timestampFormat)
+		}
+		if lentTo != "" && book.LentDate != nil {
+			lentDate = book.LentDate.UTC().Format(timestampFormat)
+		}
+
+		// At most one book is being read
+		if book.Reading {
+			if _, err := tx.Exec("UPDATE books SET reading = 0 WHERE reading != 0"); err != nil {
+				tx.Rollback()
+				return 0, err
+			}
+		}
+
+		_, err := tx.Exec(`INSERT INTO books (title, author, type, notes, location, pinned, reading, owned, lent_to, lent_date, created_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, CASE WHEN ? = '' THEN NULL ELSE COALESCE(?, CURRENT_TIMESTAMP) END, COALESCE(?, CURRENT_TIMESTAMP))`,
+			title, author, string(book.Type), strings.TrimSpace(book.Notes), strings.TrimSpace(book.Location),
+			book.Pinned, book.Reading, book.Owned, lentTo, lentTo, lentDate, createdAt)
+		if err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return len(books), nil
+}
+
+// LoadBooks retrieves all books from the database, pinned books first, each ordered by creation date (newest first).
 // It returns a slice of Book models or an error if the query fails.
 func (db *DB) LoadBooks() ([]models.Book, error) {
-	// Query all books with ordering by creation date
-	rows, err := db.conn.Query("SELECT id, title, author, type, notes, created_at, updated_at FROM books ORDER BY created_at DESC")
+	// Query all books with pinned books first, then ordering by creation date
+	rows, err := db.conn.Query("SELECT id, title, author, type, notes, location, pinned, reading, owned, lent_to, lent_date, created_at, updated_at FROM books ORDER BY pinned DESC, created_at DESC")
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
+	return scanBooks(rows)
+}
+
+// LoadBooksBetween retrieves books whose creation date falls within the given range (inclusive),
+// ordered by creation date (newest first). A zero start or end leaves that side of the range open,
+// so passing two zero times returns the whole collection.
+func (db *DB) LoadBooksBetween(start, end time.Time) ([]models.Book, error) {
+	query := "SELECT id, title, author, type, notes, location, pinned, reading, owned, lent_to, lent_date, created_at, updated_at FROM books"
+	var conditions []string
+	var args []interface{}
+
+	// created_at is stored by SQLite as UTC text, so compare against the same format
+	if !start.IsZero() {
+		conditions = append(conditions, "created_at >= ?")
+		args = append(args, start.UTC().Format(timestampFormat))
+	}
+	if !end.IsZero() {
+		conditions = append(conditions, "created_at <= ?")
+		args = append(args, end.UTC().Format(timestampFormat))
+	}
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY created_at DESC"
+
+	rows, err := db.conn.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanBooks(rows)
+}
+
+// scanBooks reads every row from a books query into a slice of Book models.
+// The query must select id, title, author, type, notes, location, pinned, reading, owned, lent_to, lent_date, created_at, and updated_at in that order.
+func scanBooks(rows *sql.Rows) ([]models.Book, error) {
 	// Process query results
 	var books []models.Book
 	for rows.Next() {
 		var b models.Book
 		var bookType string
+		var lentDate sql.NullTime
 		// Scan row data into book struct
-		err := rows.Scan(&b.ID, &b.Title, &b.Author, &bookType, &b.Notes, &b.CreatedAt, &b.UpdatedAt)
+		err := rows.Scan(&b.ID, &b.Title, &b.Author, &bookType, &b.Notes, &b.Location, &b.Pinned, &b.Reading, &b.Owned, &b.LentTo, &lentDate, &b.CreatedAt, &b.UpdatedAt)
 		if err != nil {
 			return nil, err
 		}
+		if lentDate.Valid {
+			b.LentDate = &lentDate.Time
+		}
 		// Convert string type to BookType enum
 		b.Type = models.BookType(bookType)
 		books = append(books, b)
 	}
 
 	// Check for errors that occurred during iteration
-	if err = rows.Err(); err != nil {
+	if err := rows.Err(); err != nil {
 		return nil, err
 	}
 
@@ -122,30 +325,484 @@ func (db *DB) LoadBooks() ([]models.Book, error) {
 }
 
 // UpdateBook modifies an existing book record in the database.
-// It validates input fields and updates the record's timestamp.
-func (db *DB) UpdateBook(id int, title, author string, bookType models.BookType, notes string) error {
+// It validates input fields and updates the record's timestamp. When the edit changes
+// the book, its previous values are saved to the book's history first; only the
+// latest constants.HistoryMaxVersions versions of each book are kept.
+func (db *DB) UpdateBook(id int, title, author string, bookType models.BookType, notes, location string) error {
 	// Sanitize input by trimming whitespace
 	title = strings.TrimSpace(title)
 	author = strings.TrimSpace(author)
 	notes = strings.TrimSpace(notes)
+	location = strings.TrimSpace(location)
 
 	// Validate required fields
 	if title == "" || author == "" {
 		return fmt.Errorf("title, author, and type are required")
 	}
+	if hasControlChars(title) || hasControlChars(author) {
+		return fmt.Errorf("title and author must not contain control characters such as tabs or escape codes")
+	}
+
+	tx, err := db.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	// Save the values being replaced, unless the edit leaves them as they are
+	_, err = tx.Exec(`INSERT INTO book_history (book_id, title, author, type, notes, location)
+		SELECT id, title, author, type, COALESCE(notes, ''), location FROM books
+		WHERE id = ? AND (title != ? OR author != ? OR type != ? OR COALESCE(notes, '') != ? OR location != ?)`,
+		id, title, author, string(bookType), notes, location)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
 
 	// Update book record and set updated_at timestamp
-	_, err := db.conn.Exec("UPDATE books SET title = ?, author = ?, type = ?, notes = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?", title, author, string(bookType), notes, id)
+	_, err = tx.Exec("UPDATE books SET title = ?, author = ?, type = ?, notes = ?, location = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?", title, author, string(bookType), notes, location, id)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	// Drop the oldest versions beyond the per-book limit
+	_, err = tx.Exec("DELETE FROM book_history WHERE book_id = ? AND id NOT IN (SELECT id FROM book_history WHERE book_id = ? ORDER BY id DESC LIMIT ?)", id, id, constants.HistoryMaxVersions)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// LoadHistory returns the saved earlier versions of a book, newest first.
+func (db *DB) LoadHistory(bookID int) ([]models.BookVersion, error) {
+	rows, err := db.conn.Query("SELECT id, book_id, title, author, type, notes, location, changed_at FROM book_history WHERE book_id = ? ORDER BY id DESC", bookID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var versions []models.BookVersion
+	for rows.Next() {
+		var v models.BookVersion
+		var bookType string
+		if err := rows.Scan(&v.ID, &v.BookID, &v.Title, &v.Author, &bookType, &v.Notes, &v.Location, &v.ChangedAt); err != nil {
+			return nil, err
+		}
+		v.Type = models.BookType(bookType)
+		versions = append(versions, v)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return versions, nil
+}
+
+// RestoreVersion puts a saved version's title, author, type, notes and location back on its book.
+// The restore is an edit like any other, so the values it replaces are saved to the history
+// and the restore itself can be undone. It returns the restored version.
+func (db *DB) RestoreVersion(versionID int) (models.BookVersion, error) {
+	var v models.BookVersion
+	var bookType string
+	err := db.conn.QueryRow("SELECT id, book_id, title, author, type, notes, location, changed_at FROM book_history WHERE id = ?", versionID).
+		Scan(&v.ID, &v.BookID, &v.Title, &v.Author, &bookType, &v.Notes, &v.Location, &v.ChangedAt)
+	if err == sql.ErrNoRows {
+		return v, fmt.Errorf("version %d not found", versionID)
+	}
+	if err != nil {
+		return v, err
+	}
+	v.Type = models.BookType(bookType)
+
+	return v, db.UpdateBook(v.BookID, v.Title, v.Author, v.Type, v.Notes, v.Location)
+}
+
+// UpdateBooksType changes the type of several books at once.
+// The books are updated in one transaction, so an unknown type or a missing book
+// leaves every book unchanged. Like UpdateBook, the type being replaced is saved
+// to each book's history, so the change can be undone from View History.
+func (db *DB) UpdateBooksType(ids []int, bookType models.BookType) error {
+	if parsed, ok := models.ParseBookType(string(bookType)); !ok || parsed != bookType {
+		return fmt.Errorf("unknown book type %q", bookType)
+	}
+
+	tx, err := db.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		// Save the version being replaced, unless the book already has the type
+		_, err := tx.Exec(`INSERT INTO book_history (book_id, title, author, type, notes, location)
+			SELECT id, title, author, type, COALESCE(notes, ''), location FROM books
+			WHERE id = ? AND type != ?`,
+			id, string(bookType))
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		result, err := tx.Exec("UPDATE books SET type = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?", string(bookType), id)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+		rows, err := result.RowsAffected()
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+		if rows == 0 {
+			tx.Rollback()
+			return fmt.Errorf("book %d not found", id)
+		}
+
+		// Drop the oldest versions beyond the per-book limit
+		_, err = tx.Exec("DELETE FROM book_history WHERE book_id = ? AND id NOT IN (SELECT id FROM book_history WHERE book_id = ? ORDER BY id DESC LIMIT ?)", id, id, constants.HistoryMaxVersions)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// SetPinned pins a book to the top of the list, or unpins it.
+// The book's updated_at timestamp is left alone, since pinning does not change the book itself.
+func (db *DB) SetPinned(id int, pinned bool) error {
+	_, err := db.conn.Exec("UPDATE books SET pinned = ? WHERE id = ?", pinned, id)
+	return err
+}
+
+// SetReading marks a book as the one currently being read, or clears the mark.
+// Only one book is read at a time, so marking a book clears the mark from every other book
+// in the same transaction.
+func (db *DB) SetReading(id int, reading bool) error {
+	tx, err := db.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	if reading {
+		if _, err := tx.Exec("UPDATE books SET reading = 0 WHERE reading != 0"); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if _, err := tx.Exec("UPDATE books SET reading = ? WHERE id = ?", reading, id); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// SetOwned marks a book as owned, or moves it to the wishlist of books not yet owned.
+func (db *DB) SetOwned(id int, owned bool) error {
+	_, err := db.conn.Exec("UPDATE books SET owned = ? WHERE id = ?", owned, id)
+	return err
+}
+
+// LoadWishlist retrieves the books not yet owned, ordered by creation date (newest first).
+func (db *DB) LoadWishlist() ([]models.Book, error) {
+	rows, err := db.conn.Query("SELECT id, title, author, type, notes, location, pinned, reading, owned, lent_to, lent_date, created_at, updated_at FROM books WHERE owned = 0 ORDER BY created_at DESC")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanBooks(rows)
+}
+
+// LendBook records that a book has been lent to borrower, dated now.
+// The borrower's name is trimmed and required; lending a book that is already
+// lent out replaces the borrower and date.
+func (db *DB) LendBook(id int, borrower string) error {
+	borrower = strings.TrimSpace(borrower)
+	if borrower == "" {
+		return fmt.Errorf("a borrower name is required")
+	}
+	if hasControlChars(borrower) {
+		return fmt.Errorf("borrower name must not contain control characters such as tabs or escape codes")
+	}
+	_, err := db.conn.Exec("UPDATE books SET lent_to = ?, lent_date = CURRENT_TIMESTAMP WHERE id = ?", borrower, id)
 	return err
 }
 
+// ReturnBook records that a lent book has come back, clearing its borrower and date.
+func (db *DB) ReturnBook(id int) error {
+	_, err := db.conn.Exec("UPDATE books SET lent_to = '', lent_date = NULL WHERE id = ?", id)
+	return err
+}
+
+// LoadLentBooks retrieves the books currently lent out, the longest lent first.
+func (db *DB) LoadLentBooks() ([]models.Book, error) {
+	rows, err := db.conn.Query("SELECT id, title, author, type, notes, location, pinned, reading, owned, lent_to, lent_date, created_at, updated_at FROM books WHERE lent_to != '' ORDER BY lent_date ASC, id ASC")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanBooks(rows)
+}
+
+// LoadIncompleteBooks retrieves the books whose title or author is empty or only whitespace,
+// oldest first. Such records can predate validation; they are only listed here, never changed.
+func (db *DB) LoadIncompleteBooks() ([]models.Book, error) {
+	rows, err := db.conn.Query("SELECT id, title, author, type, notes, location, pinned, reading, owned, lent_to, lent_date, created_at, updated_at FROM books WHERE TRIM(title) = '' OR TRIM(author) = '' ORDER BY created_at ASC, id ASC")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanBooks(rows)
+}
+
+// GetWishlistCount returns the number of books on the wishlist.
+func (db *DB) GetWishlistCount() (int, error) {
+	var count int
+	err := db.conn.QueryRow("SELECT COUNT(*) FROM books WHERE owned = 0").Scan(&count)
+	return count, err
+}
+
+// CurrentlyReading returns the book marked as currently being read, or nil if there is none.
+func (db *DB) CurrentlyReading() (*models.Book, error) {
+	rows, err := db.conn.Query("SELECT id, title, author, type, notes, location, pinned, reading, owned, lent_to, lent_date, created_at, updated_at FROM books WHERE reading != 0 LIMIT 1")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	books, err := scanBooks(rows)
+	if err != nil || len(books) == 0 {
+		return nil, err
+	}
+	return &books[0], nil
+}
+
 // DeleteBook removes a book from the database by its ID
 // Takes the book ID as parameter and permanently deletes the record
+// The book's edit history is deleted with it.
 func (db *DB) DeleteBook(id int) error {
+	tx, err := db.conn.Begin()
+	if err != nil {
+		return err
+	}
+
 	// Execute DELETE statement using parameterized query to prevent SQL injection
 	// The ? parameter in the sql is what paramterizes this code
-	_, err := db.conn.Exec("DELETE FROM books WHERE id = ?", id)
-	return err
+	if _, err := tx.Exec("DELETE FROM books WHERE id = ?", id); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if _, err := tx.Exec("DELETE FROM book_history WHERE book_id = ?", id); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// DeleteAllBooks permanently removes every book from the database.
+// The delete and the reset of the id sequence run in a single transaction,
+// so either the whole collection is cleared or nothing changes.
+func (db *DB) DeleteAllBooks() error {
+	tx, err := db.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec("DELETE FROM books"); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	// Ids start again from 1, so no history may be left to attach to new books
+	if _, err := tx.Exec("DELETE FROM book_history"); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	// Restart ids from 1, as they would be in a freshly created table
+	if _, err := tx.Exec("DELETE FROM sqlite_sequence WHERE name = 'books'"); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// MergeBooks merges duplicate records into the book with keepID and deletes them.
+// The kept book takes the oldest created_at of the group and the distinct, non-empty notes
+// of every record, its own first and the rest oldest first, separated by blank lines. Without
+// a location of its own it takes the first one found among the merged records, oldest first,
+// and the version it replaces is saved to its edit history like an edit.
+// It stays pinned, currently reading or owned if any merged record was, and if it is not lent
+// out it takes the borrower and lent date of the oldest merged record that is. Everything runs
+// in a single transaction, so either the whole group is merged or nothing changes.
+func (db *DB) MergeBooks(keepID int, mergeIDs []int) error {
+	if len(mergeIDs) == 0 {
+		return fmt.Errorf("no books to merge")
+	}
+
+	ids := []any{keepID}
+	seen := map[int]bool{keepID: true}
+	for _, id := range mergeIDs {
+		if seen[id] {
+			return fmt.Errorf("book %d is listed more than once", id)
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(ids)), ", ")
+
+	tx, err := db.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	rows, err := tx.Query("SELECT id, notes, location, pinned, reading, owned, lent_to FROM books WHERE id IN ("+placeholders+") ORDER BY created_at, id", ids...)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	var keepNotes, keepLocation, otherLocation string
+	var otherNotes []string
+	var pinned, reading, owned bool
+	lentID := 0 // Record whose borrower and lent date the kept book ends up with
+	found := 0
+	for rows.Next() {
+		var id int
+		var notes sql.NullString
+		var rowPinned, rowReading, rowOwned bool
+		var location, lentTo string
+		if err := rows.Scan(&id, &notes, &location, &rowPinned, &rowReading, &rowOwned, &lentTo); err != nil {
+			rows.Close()
+			tx.Rollback()
+			return err
+		}
+		found++
+		pinned = pinned || rowPinned
+		reading = reading || rowReading
+		owned = owned || rowOwned
+		if lentTo != "" && (id == keepID || lentID == 0) {
+			lentID = id
+		}
+		if id == keepID {
+			keepNotes = notes.String
+			keepLocation = location
+		} else {
+			otherNotes = append(otherNotes, notes.String)
+			if otherLocation == "" {
+				otherLocation = location
+			}
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if found != len(ids) {
+		tx.Rollback()
+		return fmt.Errorf("some of the books to merge no longer exist")
+	}
+
+	// Keep each distinct note once, ignoring surrounding whitespace
+	var notes []string
+	distinct := make(map[string]bool)
+	for _, note := range append([]string{keepNotes}, otherNotes...) {
+		note = strings.TrimSpace(note)
+		if note == "" || distinct[note] {
+			continue
+		}
+		distinct[note] = true
+		notes = append(notes, note)
+	}
+
+	// A kept book that is not lent out keeps its own, empty, lent details
+	if lentID == 0 {
+		lentID = keepID
+	}
+	location := keepLocation
+	if strings.TrimSpace(location) == "" {
+		location = otherLocation
+	}
+	mergedNotes := strings.Join(notes, "\n\n")
+
+	// Save the kept book's notes and location being replaced, unless the merge leaves them as they are
+	_, err = tx.Exec(`INSERT INTO book_history (book_id, title, author, type, notes, location)
+		SELECT id, title, author, type, COALESCE(notes, ''), location FROM books
+		WHERE id = ? AND (COALESCE(notes, '') != ? OR location != ?)`,
+		keepID, mergedNotes, location)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	_, err = tx.Exec(
+		"UPDATE books SET notes = ?, location = ?, pinned = ?, reading = ?, owned = ?, lent_to = (SELECT lent_to FROM books WHERE id = ?), lent_date = (SELECT lent_date FROM books WHERE id = ?), created_at = (SELECT MIN(created_at) FROM books WHERE id IN ("+placeholders+")), updated_at = CURRENT_TIMESTAMP WHERE id = ?",
+		append(append([]any{mergedNotes, location, pinned, reading, owned, lentID, lentID}, ids...), keepID)...,
+	)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	// Drop the oldest versions beyond the per-book limit
+	_, err = tx.Exec("DELETE FROM book_history WHERE book_id = ? AND id NOT IN (SELECT id FROM book_history WHERE book_id = ? ORDER BY id DESC LIMIT ?)", keepID, keepID, constants.HistoryMaxVersions)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if _, err := tx.Exec("DELETE FROM books WHERE id IN ("+placeholders+") AND id != ?", append(ids, keepID)...); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if _, err := tx.Exec("DELETE FROM book_history WHERE book_id IN ("+placeholders+") AND book_id != ?", append(ids, keepID)...); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// DuplicateExists reports whether a book other than excludeID already has the given title and author.
+// The comparison ignores surrounding whitespace and ASCII letter case. Pass an excludeID of 0 to
+// check against every book.
+func (db *DB) DuplicateExists(title, author string, excludeID int) (bool, error) {
+	var count int
+	err := db.conn.QueryRow(
+		"SELECT COUNT(*) FROM books WHERE trim(title) = ? COLLATE NOCASE AND trim(author) = ? COLLATE NOCASE AND id != ?",
+		strings.TrimSpace(title), strings.TrimSpace(author), excludeID,
+	).Scan(&count)
+	return count > 0, err
+}
+
+// GetAuthors returns the distinct, non-empty author names in the collection, sorted alphabetically.
+// It is used to offer autocomplete suggestions when entering an author.
+func (db *DB) GetAuthors() ([]string, error) {
+	rows, err := db.conn.Query("SELECT DISTINCT trim(author) AS name FROM books WHERE name != '' ORDER BY name COLLATE NOCASE")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var authors []string
+	for rows.Next() {
+		var author string
+		if err := rows.Scan(&author); err != nil {
+			return nil, err
+		}
+		authors = append(authors, author)
+	}
+
+	return authors, rows.Err()
 }
 
 // GetBookCount returns the total number of books in the database.
@@ -156,3 +813,103 @@ func (db *DB) GetBookCount() (int, error) {
 	err := db.conn.QueryRow("SELECT COUNT(*) FROM books").Scan(&count)
 	return count, err
 }
+
+// CountByAuthor returns how many books have the given author, including the one being viewed.
+// Like DuplicateExists, it ignores surrounding whitespace and ASCII letter case.
+func (db *DB) CountByAuthor(author string) (int, error) {
+	var count int
+	err := db.conn.QueryRow(
+		"SELECT COUNT(*) FROM books WHERE trim(author) = ? COLLATE NOCASE",
+		strings.TrimSpace(author),
+	).Scan(&count)
+	return count, err
+}
+
+// GetStatistics loads every book and summarizes the collection for the statistics screen.
+func (db *DB) GetStatistics() (models.Stats, error) {
+	books, err := db.LoadBooks()
+	if err != nil {
+		return models.Stats{}, err
+	}
+	return models.ComputeStats(books), nil
+}
+
+// GetBooksAddedByYear counts the books added in each year, by the UTC year of created_at,
+// matching the dates shown in the book list, details and timeline.
+// Years in which no books were added are left out; an empty collection returns an empty map.
+func (db *DB) GetBooksAddedByYear() (map[int]int, error) {
+	rows, err := db.conn.Query("SELECT CAST(strftime('%Y', created_at) AS INTEGER), COUNT(*) FROM books WHERE created_at IS NOT NULL GROUP BY 1")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	years := make(map[int]int)
+	for rows.Next() {
+		var year, count int
+		if err := rows.Scan(&year, &count); err != nil {
+			return nil, err
+		}
+		years[year] = count
+	}
+	return years, rows.Err()
+}
+
+// GetDatabaseInfo gathers the file path, size, schema version and row count for the Database Info screen.
+// A file that cannot be stat'ed is reported through SizeErr rather than failing the whole call.
+func (db *DB) GetDatabaseInfo() (models.DBInfo, error) {
+	info := models.DBInfo{Path: db.path}
+
+	if stat, err := os.Stat(db.path); err != nil {
+		info.SizeErr = err
+	} else {
+		info.Size = stat.Size()
+	}
+
+	// user_version records the last migration applied by createTable
+	if err := db.conn.QueryRow("PRAGMA user_version").Scan(&info.SchemaVersion); err != nil {
+		return info, err
+	}
+	if err := db.conn.QueryRow("SELECT sqlite_version()").Scan(&info.SQLiteVersion); err != nil {
+		return info, err
+	}
+
+	count, err := db.GetBookCount()
+	if err != nil {
+		return info, err
+	}
+	info.BookCount = count
+
+	return info, nil
+}
+
+// CheckIntegrity runs SQLite's integrity check against the database file.
+// It returns true when the database reports "ok", otherwise false along with
+// every problem row reported by the check.
+func (db *DB) CheckIntegrity() (bool, []string, error) {
+	// PRAGMA integrity_check returns a single "ok" row for a healthy database,
+	// or one row per problem found
+	rows, err := db.conn.Query("PRAGMA integrity_check")
+	if err != nil {
+		return false, nil, err
+	}
+	defer rows.Close()
+
+	var problems []string
+	for rows.Next() {
+		var result string
+		if err := rows.Scan(&result); err != nil {
+			return false, nil, err
+		}
+		if result != "ok" {
+			problems = append(problems, result)
+		}
+	}
+
+	// Check for errors that occurred during iteration
+	if err = rows.Err(); err != nil {
+		return false, nil, err
+	}
+
+	return len(problems) == 0, problems, nil
+}
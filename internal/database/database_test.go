@@ -1,10 +1,17 @@
 package database_test
 
 import (
+	"database/sql"
+	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/papadavis47/libros/internal/constants"
 	"github.com/papadavis47/libros/internal/database"
 	"github.com/papadavis47/libros/internal/models"
 )
@@ -29,7 +36,7 @@ func TestDatabase_BasicOperations(t *testing.T) {
 
 	// Test CREATE operation
 	t.Run("SaveBook", func(t *testing.T) {
-		err := db.SaveBook("Test Book", "Test Author", models.Paperback, "Test notes")
+		err := db.SaveBook("Test Book", "Test Author", models.Paperback, "Test notes", "")
 		if err != nil {
 			t.Fatalf("Failed to save book: %v", err)
 		}
@@ -38,12 +45,12 @@ func TestDatabase_BasicOperations(t *testing.T) {
 	// Test READ operation
 	t.Run("LoadBooks", func(t *testing.T) {
 		// Add a few more books
-		err := db.SaveBook("Book 1", "Author 1", models.Paperback, "Notes 1")
+		err := db.SaveBook("Book 1", "Author 1", models.Paperback, "Notes 1", "")
 		if err != nil {
 			t.Fatalf("Failed to save book 1: %v", err)
 		}
 		
-		err = db.SaveBook("Book 2", "Author 2", models.Hardback, "Notes 2")
+		err = db.SaveBook("Book 2", "Author 2", models.Hardback, "Notes 2", "")
 		if err != nil {
 			t.Fatalf("Failed to save book 2: %v", err)
 		}
@@ -100,7 +107,7 @@ func TestDatabase_BasicOperations(t *testing.T) {
 
 		// Update the first book
 		bookID := books[0].ID
-		err = db.UpdateBook(bookID, "Updated Title", "Updated Author", models.Digital, "Updated notes")
+		err = db.UpdateBook(bookID, "Updated Title", "Updated Author", models.Digital, "Updated notes", "")
 		if err != nil {
 			t.Fatalf("Failed to update book: %v", err)
 		}
@@ -213,7 +220,7 @@ func TestDatabase_EdgeCases(t *testing.T) {
 		author := "Author with àccénts and ñoñ-ASCII"
 		notes := "Notes with 'quotes', \"double quotes\", and unicode: ★☆★"
 
-		err := db.SaveBook(title, author, models.Digital, notes)
+		err := db.SaveBook(title, author, models.Digital, notes, "")
 		if err != nil {
 			t.Fatalf("Failed to save book with special characters: %v", err)
 		}
@@ -247,7 +254,7 @@ func TestDatabase_EdgeCases(t *testing.T) {
 	t.Run("UpdateNonexistentBook", func(t *testing.T) {
 		// This tests that updating a nonexistent book doesn't crash
 		// The actual behavior may vary based on implementation
-		err := db.UpdateBook(99999, "Nonexistent", "Ghost", models.Paperback, "Notes")
+		err := db.UpdateBook(99999, "Nonexistent", "Ghost", models.Paperback, "Notes", "")
 		// We just verify the operation completes without crashing
 		_ = err // Some implementations may or may not return an error
 	})
@@ -259,4 +266,1345 @@ func TestDatabase_EdgeCases(t *testing.T) {
 		// We just verify the operation completes without crashing
 		_ = err // Some implementations may or may not return an error
 	})
-}
\ No newline at end of file
+}
+// openTestDB creates a fresh database in a temporary directory for a single test
+// The database and its directory are removed automatically when the test finishes
+func openTestDB(t *testing.T) *database.DB {
+	t.Helper()
+
+	tempDir, err := os.MkdirTemp("", "libros_test")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	t.Cleanup(func() { os.RemoveAll(tempDir) })
+
+	db, err := database.New(filepath.Join(tempDir, "test.db"))
+	if err != nil {
+		t.Fatalf("Failed to create database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	return db
+}
+
+// TestDatabase_CheckIntegrity tests that a healthy database passes the integrity check
+func TestDatabase_CheckIntegrity(t *testing.T) {
+	db := openTestDB(t)
+
+	if err := db.SaveBook("Integrity Book", "Integrity Author", models.Paperback, "Notes", ""); err != nil {
+		t.Fatalf("Failed to save book: %v", err)
+	}
+
+	ok, problems, err := db.CheckIntegrity()
+	if err != nil {
+		t.Fatalf("CheckIntegrity() returned error: %v", err)
+	}
+	if !ok {
+		t.Errorf("CheckIntegrity() = false, want true; problems: %v", problems)
+	}
+	if len(problems) != 0 {
+		t.Errorf("Expected no problems, got %d: %v", len(problems), problems)
+	}
+}
+
+// TestDatabase_ImportBooks tests adding several books in one transaction
+// A book that fails to insert leaves the collection unchanged
+func TestDatabase_ImportBooks(t *testing.T) {
+	db := openTestDB(t)
+
+	imported, err := db.ImportBooks([]models.Book{
+		{Title: " Dune ", Author: "Frank Herbert", Type: models.Paperback, Owned: true},
+		{Title: "Beloved", Author: "Toni Morrison", Type: models.Paperback, Owned: true},
+	})
+	if err != nil {
+		t.Fatalf("ImportBooks() returned error: %v", err)
+	}
+	if imported != 2 {
+		t.Errorf("ImportBooks() = %d, want 2", imported)
+	}
+
+	books, err := db.LoadBooks()
+	if err != nil {
+		t.Fatalf("Failed to load books: %v", err)
+	}
+	if len(books) != 2 {
+		t.Fatalf("Expected 2 books, got %d", len(books))
+	}
+	titles := map[string]bool{}
+	for _, book := range books {
+		titles[book.Title] = true
+		if !book.Owned {
+			t.Errorf("Imported book %q should be owned", book.Title)
+		}
+	}
+	if !titles["Dune"] || !titles["Beloved"] {
+		t.Errorf("Imported titles = %v, want trimmed Dune and Beloved", titles)
+	}
+
+	// An invalid book rolls back the whole import
+	if _, err := db.ImportBooks([]models.Book{
+		{Title: "Valid", Author: "Author", Type: models.Paperback},
+		{Title: "", Author: "Author", Type: models.Paperback},
+	}); err == nil {
+		t.Error("ImportBooks() with an empty title should fail")
+	}
+	count, err := db.GetBookCount()
+	if err != nil {
+		t.Fatalf("Failed to count books: %v", err)
+	}
+	if count != 2 {
+		t.Errorf("Book count after failed import = %d, want 2", count)
+	}
+}
+
+// TestDatabase_ImportBooksKeepsDetails tests that importing an export keeps each book's
+// creation date, pinned, reading and lent details, and that only one book stays being read
+func TestDatabase_ImportBooksKeepsDetails(t *testing.T) {
+	db := openTestDB(t)
+
+	if err := db.SaveBook("Current", "Someone", models.Paperback, "", ""); err != nil {
+		t.Fatalf("SaveBook() returned error: %v", err)
+	}
+	books, err := db.LoadBooks()
+	if err != nil {
+		t.Fatalf("Failed to load books: %v", err)
+	}
+	if err := db.SetReading(books[0].ID, true); err != nil {
+		t.Fatalf("SetReading() returned error: %v", err)
+	}
+
+	created := time.Date(2020, 3, 14, 9, 26, 53, 0, time.UTC)
+	lent := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	if _, err := db.ImportBooks([]models.Book{
+		{Title: "Dune", Author: "Frank Herbert", Type: models.Paperback, Owned: true,
+			Pinned: true, Reading: true, LentTo: " Sam ", LentDate: &lent, CreatedAt: created},
+		{Title: "Beloved", Author: "Toni Morrison", Type: models.Paperback, Owned: true},
+	}); err != nil {
+		t.Fatalf("ImportBooks() returned error: %v", err)
+	}
+
+	books, err = db.LoadBooks()
+	if err != nil {
+		t.Fatalf("Failed to load books: %v", err)
+	}
+	byTitle := map[string]models.Book{}
+	for _, book := range books {
+		byTitle[book.Title] = book
+	}
+
+	dune := byTitle["Dune"]
+	if !dune.CreatedAt.Equal(created) {
+		t.Errorf("Dune CreatedAt = %v, want %v", dune.CreatedAt, created)
+	}
+	if !dune.Pinned || !dune.Reading {
+		t.Errorf("Dune pinned = %v, reading = %v, want both true", dune.Pinned, dune.Reading)
+	}
+	if dune.LentTo != "Sam" || dune.LentDate == nil || !dune.LentDate.Equal(lent) {
+		t.Errorf("Dune lent to %q on %v, want Sam on %v", dune.LentTo, dune.LentDate, lent)
+	}
+
+	beloved := byTitle["Beloved"]
+	if beloved.CreatedAt.IsZero() || beloved.CreatedAt.Before(created) {
+		t.Errorf("Beloved CreatedAt = %v, want the import time", beloved.CreatedAt)
+	}
+	if beloved.Pinned || beloved.Reading || beloved.LentTo != "" || beloved.LentDate != nil {
+		t.Errorf("Beloved should not be pinned, read or lent, got %+v", beloved)
+	}
+
+	// The imported book being read takes over from the existing one
+	if byTitle["Current"].Reading {
+		t.Error("Current should no longer be marked as being read")
+	}
+}
+
+// TestDatabase_ControlCharacters tests that titles and authors with control characters
+// are rejected when saving, updating and importing, leaving the collection unchanged
+func TestDatabase_ControlCharacters(t *testing.T) {
+	db := openTestDB(t)
+
+	if err := db.SaveBook("Dune", "Frank Herbert", models.Paperback, "", ""); err != nil {
+		t.Fatalf("SaveBook() returned error: %v", err)
+	}
+	books, err := db.LoadBooks()
+	if err != nil {
+		t.Fatalf("Failed to load books: %v", err)
+	}
+	id := books[0].ID
+
+	tests := []struct {
+		name   string
+		title  string
+		author string
+	}{
+		{"tab in title", "Du\tne", "Frank Herbert"},
+		{"NUL in author", "Dune", "Frank\x00Herbert"},
+		{"ANSI escape in title", "\x1b[31mDune\x1b[0m", "Frank Herbert"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := db.SaveBook(tt.title, tt.author, models.Paperback, "", ""); err == nil {
+				t.Error("SaveBook() should reject control characters")
+			}
+			if err := db.UpdateBook(id, tt.title, tt.author, models.Paperback, "", ""); err == nil {
+				t.Error("UpdateBook() should reject control characters")
+			}
+			if _, err := db.ImportBooks([]models.Book{{Title: tt.title, Author: tt.author, Type: models.Paperback}}); err == nil {
+				t.Error("ImportBooks() should reject control characters")
+			}
+		})
+	}
+
+	books, err = db.LoadBooks()
+	if err != nil {
+		t.Fatalf("Failed to load books: %v", err)
+	}
+	if len(books) != 1 || books[0].Title != "Dune" || books[0].Author != "Frank Herbert" {
+		t.Errorf("Books after rejected writes = %+v, want only the unchanged Dune", books)
+	}
+}
+
+// TestDatabase_History tests that edits save the replaced values, that saving without
+// changes adds nothing, that the history is capped and that a version can be restored
+func TestDatabase_History(t *testing.T) {
+	db := openTestDB(t)
+
+	if err := db.SaveBook("Dune", "Frank Herbert", models.Paperback, "First read", ""); err != nil {
+		t.Fatalf("SaveBook() returned error: %v", err)
+	}
+	books, err := db.LoadBooks()
+	if err != nil {
+		t.Fatalf("Failed to load books: %v", err)
+	}
+	id := books[0].ID
+
+	// Saving the same values is not an edit
+	if err := db.UpdateBook(id, "Dune", "Frank Herbert", models.Paperback, "First read", ""); err != nil {
+		t.Fatalf("UpdateBook() returned error: %v", err)
+	}
+	versions, err := db.LoadHistory(id)
+	if err != nil {
+		t.Fatalf("LoadHistory() returned error: %v", err)
+	}
+	if len(versions) != 0 {
+		t.Errorf("History after an unchanged save has %d versions, want 0", len(versions))
+	}
+
+	if err := db.UpdateBook(id, "Dune Messiah", "Frank Herbert", models.Hardback, "Second read", "Shelf A"); err != nil {
+		t.Fatalf("UpdateBook() returned error: %v", err)
+	}
+	versions, err = db.LoadHistory(id)
+	if err != nil {
+		t.Fatalf("LoadHistory() returned error: %v", err)
+	}
+	if len(versions) != 1 {
+		t.Fatalf("History after one edit has %d versions, want 1", len(versions))
+	}
+	first := versions[0]
+	if first.BookID != id || first.Title != "Dune" || first.Type != models.Paperback || first.Notes != "First read" || first.Location != "" {
+		t.Errorf("Saved version = %+v, want the values before the edit", first)
+	}
+
+	// Restoring puts the old values back and saves the replaced ones in turn
+	restored, err := db.RestoreVersion(first.ID)
+	if err != nil {
+		t.Fatalf("RestoreVersion() returned error: %v", err)
+	}
+	if restored.Title != "Dune" {
+		t.Errorf("RestoreVersion() = %+v, want the Dune version", restored)
+	}
+	books, err = db.LoadBooks()
+	if err != nil {
+		t.Fatalf("Failed to load books: %v", err)
+	}
+	if books[0].Title != "Dune" || books[0].Type != models.Paperback || books[0].Notes != "First read" || books[0].Location != "" {
+		t.Errorf("Book after restore = %+v, want the first version", books[0])
+	}
+	versions, err = db.LoadHistory(id)
+	if err != nil {
+		t.Fatalf("LoadHistory() returned error: %v", err)
+	}
+	if len(versions) != 2 || versions[0].Title != "Dune Messiah" {
+		t.Errorf("History after restore = %+v, want the Dune Messiah version newest", versions)
+	}
+
+	if _, err := db.RestoreVersion(99999); err == nil {
+		t.Error("RestoreVersion() of a missing version should fail")
+	}
+
+	// Only the newest versions are kept
+	for i := 0; i < constants.HistoryMaxVersions+5; i++ {
+		if err := db.UpdateBook(id, fmt.Sprintf("Dune %d", i), "Frank Herbert", models.Paperback, "", ""); err != nil {
+			t.Fatalf("UpdateBook() returned error: %v", err)
+		}
+	}
+	versions, err = db.LoadHistory(id)
+	if err != nil {
+		t.Fatalf("LoadHistory() returned error: %v", err)
+	}
+	if len(versions) != constants.HistoryMaxVersions {
+		t.Errorf("History has %d versions, want the cap of %d", len(versions), constants.HistoryMaxVersions)
+	}
+	if want := fmt.Sprintf("Dune %d", constants.HistoryMaxVersions+3); versions[0].Title != want {
+		t.Errorf("Newest version = %q, want %q", versions[0].Title, want)
+	}
+
+	// Deleting the book deletes its history
+	if err := db.DeleteBook(id); err != nil {
+		t.Fatalf("DeleteBook() returned error: %v", err)
+	}
+	versions, err = db.LoadHistory(id)
+	if err != nil {
+		t.Fatalf("LoadHistory() returned error: %v", err)
+	}
+	if len(versions) != 0 {
+		t.Errorf("History of a deleted book has %d versions, want 0", len(versions))
+	}
+}
+
+// TestDatabase_UpdateBooksType tests changing the type of several books at once
+// A missing book or an unknown type rolls back the whole change
+func TestDatabase_UpdateBooksType(t *testing.T) {
+	db := openTestDB(t)
+
+	for _, title := range []string{"Dune", "Beloved", "Emma"} {
+		if err := db.SaveBook(title, "Author", models.Paperback, "", ""); err != nil {
+			t.Fatalf("Failed to save %q: %v", title, err)
+		}
+	}
+	books, err := db.LoadBooks()
+	if err != nil {
+		t.Fatalf("Failed to load books: %v", err)
+	}
+	ids := map[string]int{}
+	for _, book := range books {
+		ids[book.Title] = book.ID
+	}
+
+	if err := db.UpdateBooksType([]int{ids["Dune"], ids["Emma"]}, models.Audio); err != nil {
+		t.Fatalf("UpdateBooksType() returned error: %v", err)
+	}
+
+	// A missing ID fails after the first book would have been changed
+	if err := db.UpdateBooksType([]int{ids["Beloved"], 9999}, models.Digital); err == nil {
+		t.Error("UpdateBooksType() with a missing book should fail")
+	}
+	if err := db.UpdateBooksType([]int{ids["Beloved"]}, models.BookType("scroll")); err == nil {
+		t.Error("UpdateBooksType() with an unknown type should fail")
+	}
+
+	books, err = db.LoadBooks()
+	if err != nil {
+		t.Fatalf("Failed to load books: %v", err)
+	}
+	want := map[string]models.BookType{"Dune": models.Audio, "Beloved": models.Paperback, "Emma": models.Audio}
+	for _, book := range books {
+		if book.Type != want[book.Title] {
+			t.Errorf("%q type = %q, want %q", book.Title, book.Type, want[book.Title])
+		}
+	}
+	// Each changed book keeps its old type in its history; failed changes leave none
+	wantHistory := map[string]int{"Dune": 1, "Beloved": 0, "Emma": 1}
+	for title, want := range wantHistory {
+		versions, err := db.LoadHistory(ids[title])
+		if err != nil {
+			t.Fatalf("LoadHistory(%q) returned error: %v", title, err)
+		}
+		if len(versions) != want {
+			t.Fatalf("%q has %d history versions, want %d", title, len(versions), want)
+		}
+		if want > 0 && versions[0].Type != models.Paperback {
+			t.Errorf("%q history type = %q, want %q", title, versions[0].Type, models.Paperback)
+		}
+	}
+
+	// Changing to the type a book already has saves no version
+	if err := db.UpdateBooksType([]int{ids["Dune"]}, models.Audio); err != nil {
+		t.Fatalf("UpdateBooksType() returned error: %v", err)
+	}
+	if versions, err := db.LoadHistory(ids["Dune"]); err != nil || len(versions) != 1 {
+		t.Errorf("History after an unchanged type = %d versions (err %v), want 1", len(versions), err)
+	}
+}
+
+// TestDatabase_LoadIncompleteBooks tests finding books with an empty or whitespace-only
+// title or author, which can only come from records saved before validation existed
+func TestDatabase_LoadIncompleteBooks(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "old.db")
+	db, err := database.New(dbPath)
+	if err != nil {
+		t.Fatalf("Failed to create database: %v", err)
+	}
+	if err := db.SaveBook("Complete", "Author", models.Paperback, "", ""); err != nil {
+		t.Fatalf("Failed to save book: %v", err)
+	}
+	db.Close()
+
+	// Insert records that SaveBook would reject
+	conn, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	if _, err := conn.Exec("INSERT INTO books (title, author, notes) VALUES ('No Author', '', ''), ('   ', 'No Title', '')"); err != nil {
+		t.Fatalf("Failed to insert books: %v", err)
+	}
+	conn.Close()
+
+	db, err = database.New(dbPath)
+	if err != nil {
+		t.Fatalf("Failed to reopen database: %v", err)
+	}
+	defer db.Close()
+
+	books, err := db.LoadIncompleteBooks()
+	if err != nil {
+		t.Fatalf("LoadIncompleteBooks() returned error: %v", err)
+	}
+	if len(books) != 2 || books[0].Title != "No Author" || books[1].Author != "No Title" {
+		t.Fatalf("LoadIncompleteBooks() = %+v, want the two incomplete books oldest first", books)
+	}
+
+	// Once fixed, a book is no longer reported
+	if err := db.UpdateBook(books[0].ID, "No Author", "Unknown Author", books[0].Type, "", ""); err != nil {
+		t.Fatalf("Failed to fix book: %v", err)
+	}
+	books, err = db.LoadIncompleteBooks()
+	if err != nil {
+		t.Fatalf("LoadIncompleteBooks() returned error: %v", err)
+	}
+	if len(books) != 1 || books[0].Author != "No Title" {
+		t.Errorf("LoadIncompleteBooks() after fix = %+v, want only the untitled book", books)
+	}
+}
+
+// TestDatabase_GetDatabaseInfo tests gathering details about the database file
+// A missing file is reported through SizeErr instead of failing the call
+func TestDatabase_GetDatabaseInfo(t *testing.T) {
+	db := openTestDB(t)
+
+	for _, title := range []string{"Info One", "Info Two"} {
+		if err := db.SaveBook(title, "Info Author", models.Paperback, "", ""); err != nil {
+			t.Fatalf("Failed to save book %q: %v", title, err)
+		}
+	}
+
+	info, err := db.GetDatabaseInfo()
+	if err != nil {
+		t.Fatalf("GetDatabaseInfo() returned error: %v", err)
+	}
+	if info.Path != db.Path() {
+		t.Errorf("Path = %q, want %q", info.Path, db.Path())
+	}
+	if info.SizeErr != nil || info.Size <= 0 {
+		t.Errorf("Size = %d, SizeErr = %v; want a positive size", info.Size, info.SizeErr)
+	}
+	if info.SQLiteVersion == "" {
+		t.Error("SQLiteVersion is empty")
+	}
+	if info.BookCount != 2 {
+		t.Errorf("BookCount = %d, want 2", info.BookCount)
+	}
+	if info.SchemaVersion != database.SchemaVersion {
+		t.Errorf("SchemaVersion = %d, want %d", info.SchemaVersion, database.SchemaVersion)
+	}
+
+	// Remove the file out from under the open connection
+	if err := os.Remove(db.Path()); err != nil {
+		t.Fatalf("Failed to remove database file: %v", err)
+	}
+	info, err = db.GetDatabaseInfo()
+	if err != nil {
+		t.Fatalf("GetDatabaseInfo() after removal returned error: %v", err)
+	}
+	if !errors.Is(info.SizeErr, os.ErrNotExist) {
+		t.Errorf("SizeErr = %v, want a not-exist error", info.SizeErr)
+	}
+}
+
+// TestDatabase_LoadBooksBetween tests filtering books by creation date
+// Zero bounds leave that side of the range open
+func TestDatabase_LoadBooksBetween(t *testing.T) {
+	db := openTestDB(t)
+
+	for _, title := range []string{"First", "Second", "Third"} {
+		if err := db.SaveBook(title, "Range Author", models.Paperback, "", ""); err != nil {
+			t.Fatalf("Failed to save book %q: %v", title, err)
+		}
+	}
+
+	now := time.Now()
+	tests := []struct {
+		name     string
+		start    time.Time
+		end      time.Time
+		expected int
+	}{
+		{"range around now", now.Add(-time.Hour), now.Add(time.Hour), 3},
+		{"open bounds", time.Time{}, time.Time{}, 3},
+		{"open start", time.Time{}, now.Add(time.Hour), 3},
+		{"open end", now.Add(-time.Hour), time.Time{}, 3},
+		{"range in the future", now.Add(time.Hour), now.Add(2 * time.Hour), 0},
+		{"range in the past", now.Add(-2 * time.Hour), now.Add(-time.Hour), 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			books, err := db.LoadBooksBetween(tt.start, tt.end)
+			if err != nil {
+				t.Fatalf("LoadBooksBetween() returned error: %v", err)
+			}
+			if len(books) != tt.expected {
+				t.Errorf("LoadBooksBetween() returned %d books, want %d", len(books), tt.expected)
+			}
+		})
+	}
+}
+
+// TestDatabase_DuplicateExists tests title+author duplicate detection
+// The book's own ID must be excluded so unchanged edits aren't flagged
+func TestDatabase_DuplicateExists(t *testing.T) {
+	db := openTestDB(t)
+
+	if err := db.SaveBook("Dune", "Frank Herbert", models.Paperback, "", ""); err != nil {
+		t.Fatalf("Failed to save book: %v", err)
+	}
+	if err := db.SaveBook("Emma", "Jane Austen", models.Hardback, "", ""); err != nil {
+		t.Fatalf("Failed to save book: %v", err)
+	}
+
+	books, err := db.LoadBooks()
+	if err != nil {
+		t.Fatalf("Failed to load books: %v", err)
+	}
+	var duneID, emmaID int
+	for _, book := range books {
+		switch book.Title {
+		case "Dune":
+			duneID = book.ID
+		case "Emma":
+			emmaID = book.ID
+		}
+	}
+
+	tests := []struct {
+		name      string
+		title     string
+		author    string
+		excludeID int
+		expected  bool
+	}{
+		{"exact match from another book", "Dune", "Frank Herbert", emmaID, true},
+		{"case and whitespace insensitive", "  dune ", "FRANK HERBERT", emmaID, true},
+		{"own record excluded", "Dune", "Frank Herbert", duneID, false},
+		{"same title different author", "Dune", "Someone Else", emmaID, false},
+		{"no match", "Unknown", "Nobody", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exists, err := db.DuplicateExists(tt.title, tt.author, tt.excludeID)
+			if err != nil {
+				t.Fatalf("DuplicateExists() returned error: %v", err)
+			}
+			if exists != tt.expected {
+				t.Errorf("DuplicateExists(%q, %q, %d) = %v, want %v", tt.title, tt.author, tt.excludeID, exists, tt.expected)
+			}
+		})
+	}
+}
+
+// TestDatabase_CountByAuthor tests counting an author's books for the detail stats line
+func TestDatabase_CountByAuthor(t *testing.T) {
+	db := openTestDB(t)
+
+	for _, book := range []struct{ title, author string }{
+		{"Dune", "Frank Herbert"},
+		{"Children of Dune", " frank herbert "},
+		{"Emma", "Jane Austen"},
+	} {
+		if err := db.SaveBook(book.title, book.author, models.Paperback, "", ""); err != nil {
+			t.Fatalf("Failed to save book: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		author   string
+		expected int
+	}{
+		{"case and whitespace insensitive", "Frank Herbert", 2},
+		{"single book", "Jane Austen", 1},
+		{"unknown author", "Nobody", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			count, err := db.CountByAuthor(tt.author)
+			if err != nil {
+				t.Fatalf("CountByAuthor() returned error: %v", err)
+			}
+			if count != tt.expected {
+				t.Errorf("CountByAuthor(%q) = %d, want %d", tt.author, count, tt.expected)
+			}
+		})
+	}
+}
+
+// TestDatabase_DeleteAllBooks tests clearing the whole collection
+func TestDatabase_DeleteAllBooks(t *testing.T) {
+	db := openTestDB(t)
+
+	for _, title := range []string{"First", "Second", "Third"} {
+		if err := db.SaveBook(title, "Author", models.Paperback, "", ""); err != nil {
+			t.Fatalf("Failed to save book: %v", err)
+		}
+	}
+
+	if err := db.DeleteAllBooks(); err != nil {
+		t.Fatalf("DeleteAllBooks() returned error: %v", err)
+	}
+
+	count, err := db.GetBookCount()
+	if err != nil {
+		t.Fatalf("Failed to get book count: %v", err)
+	}
+	if count != 0 {
+		t.Errorf("Expected 0 books after DeleteAllBooks, got %d", count)
+	}
+
+	// Clearing an empty collection should also succeed
+	if err := db.DeleteAllBooks(); err != nil {
+		t.Errorf("DeleteAllBooks() on empty table returned error: %v", err)
+	}
+
+	// Ids restart from 1 after the collection is cleared
+	if err := db.SaveBook("Fresh Start", "Author", models.Paperback, "", ""); err != nil {
+		t.Fatalf("Failed to save book: %v", err)
+	}
+	books, err := db.LoadBooks()
+	if err != nil {
+		t.Fatalf("Failed to load books: %v", err)
+	}
+	if len(books) != 1 || books[0].ID != 1 {
+		t.Errorf("Expected a single book with ID 1, got %+v", books)
+	}
+}
+
+// TestDatabase_GetAuthors tests loading distinct author names for autocomplete
+func TestDatabase_GetAuthors(t *testing.T) {
+	db := openTestDB(t)
+
+	authors, err := db.GetAuthors()
+	if err != nil {
+		t.Fatalf("GetAuthors() returned error: %v", err)
+	}
+	if len(authors) != 0 {
+		t.Errorf("Expected no authors in empty database, got %v", authors)
+	}
+
+	books := []struct{ title, author string }{
+		{"Emma", "Jane Austen"},
+		{"Persuasion", "Jane Austen"},
+		{"Dune", "  Frank Herbert  "},
+		{"Beloved", "toni Morrison"},
+	}
+	for _, book := range books {
+		if err := db.SaveBook(book.title, book.author, models.Paperback, "", ""); err != nil {
+			t.Fatalf("Failed to save book: %v", err)
+		}
+	}
+
+	authors, err = db.GetAuthors()
+	if err != nil {
+		t.Fatalf("GetAuthors() returned error: %v", err)
+	}
+
+	expected := []string{"Frank Herbert", "Jane Austen", "toni Morrison"}
+	if len(authors) != len(expected) {
+		t.Fatalf("GetAuthors() = %v, want %v", authors, expected)
+	}
+	for i := range expected {
+		if authors[i] != expected[i] {
+			t.Errorf("GetAuthors()[%d] = %q, want %q", i, authors[i], expected[i])
+		}
+	}
+}
+
+// TestDatabase_Location tests saving, loading and updating a book's shelf location
+func TestDatabase_Location(t *testing.T) {
+	db := openTestDB(t)
+
+	if err := db.SaveBook("Shelved", "Author", models.Hardback, "", "  Shelf B, top  "); err != nil {
+		t.Fatalf("Failed to save book: %v", err)
+	}
+	if err := db.SaveBook("Unshelved", "Author", models.Digital, "", ""); err != nil {
+		t.Fatalf("Failed to save book: %v", err)
+	}
+
+	books, err := db.LoadBooks()
+	if err != nil {
+		t.Fatalf("Failed to load books: %v", err)
+	}
+	locations := make(map[string]string)
+	var shelvedID int
+	for _, book := range books {
+		locations[book.Title] = book.Location
+		if book.Title == "Shelved" {
+			shelvedID = book.ID
+		}
+	}
+	if locations["Shelved"] != "Shelf B, top" {
+		t.Errorf("Expected trimmed location %q, got %q", "Shelf B, top", locations["Shelved"])
+	}
+	if locations["Unshelved"] != "" {
+		t.Errorf("Expected empty location, got %q", locations["Unshelved"])
+	}
+
+	if err := db.UpdateBook(shelvedID, "Shelved", "Author", models.Hardback, "", "Box 3"); err != nil {
+		t.Fatalf("Failed to update book: %v", err)
+	}
+	books, err = db.LoadBooks()
+	if err != nil {
+		t.Fatalf("Failed to load books: %v", err)
+	}
+	for _, book := range books {
+		if book.ID == shelvedID && book.Location != "Box 3" {
+			t.Errorf("Expected updated location %q, got %q", "Box 3", book.Location)
+		}
+	}
+}
+
+// TestDatabase_LocationMigration tests that a database created before the location column existed
+// is upgraded on open and its existing books load with an empty location
+func TestDatabase_LocationMigration(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "old.db")
+
+	// Create a books table using the schema from before the location column was added
+	conn, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	_, err = conn.Exec(`CREATE TABLE books (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		title TEXT NOT NULL,
+		author TEXT NOT NULL,
+		type TEXT NOT NULL DEFAULT 'paperback',
+		notes TEXT,
+		created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+		updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+	)`)
+	if err != nil {
+		t.Fatalf("Failed to create old schema: %v", err)
+	}
+	if _, err := conn.Exec("INSERT INTO books (title, author, notes) VALUES ('Old Book', 'Old Author', '')"); err != nil {
+		t.Fatalf("Failed to insert book: %v", err)
+	}
+	conn.Close()
+
+	db, err := database.New(dbPath)
+	if err != nil {
+		t.Fatalf("Failed to open old database: %v", err)
+	}
+	defer db.Close()
+
+	books, err := db.LoadBooks()
+	if err != nil {
+		t.Fatalf("Failed to load books after migration: %v", err)
+	}
+	if len(books) != 1 || books[0].Location != "" || books[0].Pinned || books[0].Reading || !books[0].Owned || books[0].LentTo != "" || books[0].LentDate != nil {
+		t.Errorf("Expected one unpinned, unread, owned, unlent book with empty location, got %+v", books)
+	}
+
+	// Every migration is recorded, so the database reports the current schema version
+	info, err := db.GetDatabaseInfo()
+	if err != nil {
+		t.Fatalf("GetDatabaseInfo() returned error: %v", err)
+	}
+	if info.SchemaVersion != database.SchemaVersion {
+		t.Errorf("SchemaVersion after migration = %d, want %d", info.SchemaVersion, database.SchemaVersion)
+	}
+}
+
+// TestDatabase_SetPinned tests that pinned books load first, newest first among themselves,
+// and that unpinning returns a book to its normal place
+func TestDatabase_SetPinned(t *testing.T) {
+	db := openTestDB(t)
+
+	for _, title := range []string{"Oldest", "Middle", "Newest"} {
+		if err := db.SaveBook(title, "Author", models.Paperback, "", ""); err != nil {
+			t.Fatalf("Failed to save book: %v", err)
+		}
+		// created_at has one-second resolution, so space the books out
+		time.Sleep(1100 * time.Millisecond)
+	}
+
+	books, err := db.LoadBooks()
+	if err != nil {
+		t.Fatalf("Failed to load books: %v", err)
+	}
+	ids := make(map[string]int)
+	for _, book := range books {
+		ids[book.Title] = book.ID
+	}
+
+	titles := func() []string {
+		t.Helper()
+		books, err := db.LoadBooks()
+		if err != nil {
+			t.Fatalf("Failed to load books: %v", err)
+		}
+		var titles []string
+		for _, book := range books {
+			title := book.Title
+			if book.Pinned {
+				title += " (pinned)"
+			}
+			titles = append(titles, title)
+		}
+		return titles
+	}
+
+	if err := db.SetPinned(ids["Oldest"], true); err != nil {
+		t.Fatalf("SetPinned() returned error: %v", err)
+	}
+	if err := db.SetPinned(ids["Middle"], true); err != nil {
+		t.Fatalf("SetPinned() returned error: %v", err)
+	}
+	want := []string{"Middle (pinned)", "Oldest (pinned)", "Newest"}
+	if got := titles(); strings.Join(got, ", ") != strings.Join(want, ", ") {
+		t.Errorf("LoadBooks() order = %v, want %v", got, want)
+	}
+
+	if err := db.SetPinned(ids["Middle"], false); err != nil {
+		t.Fatalf("SetPinned() returned error: %v", err)
+	}
+	want = []string{"Oldest (pinned)", "Newest", "Middle"}
+	if got := titles(); strings.Join(got, ", ") != strings.Join(want, ", ") {
+		t.Errorf("LoadBooks() order after unpinning = %v, want %v", got, want)
+	}
+}
+
+// TestDatabase_SetReading tests that at most one book is marked as currently reading
+// and that CurrentlyReading reports it
+func TestDatabase_SetReading(t *testing.T) {
+	db := openTestDB(t)
+
+	for _, title := range []string{"Dune", "Emma"} {
+		if err := db.SaveBook(title, "Author", models.Paperback, "", ""); err != nil {
+			t.Fatalf("Failed to save book: %v", err)
+		}
+	}
+	books, err := db.LoadBooks()
+	if err != nil {
+		t.Fatalf("Failed to load books: %v", err)
+	}
+	ids := make(map[string]int)
+	for _, book := range books {
+		ids[book.Title] = book.ID
+	}
+
+	// No book is marked to begin with
+	book, err := db.CurrentlyReading()
+	if err != nil {
+		t.Fatalf("CurrentlyReading() returned error: %v", err)
+	}
+	if book != nil {
+		t.Errorf("CurrentlyReading() = %q, want nil", book.Title)
+	}
+
+	// Marking a second book moves the mark rather than adding another
+	for _, title := range []string{"Dune", "Emma"} {
+		if err := db.SetReading(ids[title], true); err != nil {
+			t.Fatalf("SetReading() returned error: %v", err)
+		}
+		book, err := db.CurrentlyReading()
+		if err != nil {
+			t.Fatalf("CurrentlyReading() returned error: %v", err)
+		}
+		if book == nil || book.Title != title || !book.Reading {
+			t.Errorf("CurrentlyReading() = %+v, want %q", book, title)
+		}
+	}
+	books, err = db.LoadBooks()
+	if err != nil {
+		t.Fatalf("Failed to load books: %v", err)
+	}
+	reading := 0
+	for _, b := range books {
+		if b.Reading {
+			reading++
+		}
+	}
+	if reading != 1 {
+		t.Errorf("Expected exactly one book marked as reading, got %d", reading)
+	}
+
+	// Clearing the mark leaves no book marked
+	if err := db.SetReading(ids["Emma"], false); err != nil {
+		t.Fatalf("SetReading() returned error: %v", err)
+	}
+	if book, err := db.CurrentlyReading(); err != nil || book != nil {
+		t.Errorf("CurrentlyReading() after clearing = %+v, %v, want nil, nil", book, err)
+	}
+}
+
+// TestDatabase_MergeBooks tests that merging keeps the oldest created_at, combines distinct
+// notes once each, and deletes the merged records
+func TestDatabase_MergeBooks(t *testing.T) {
+	db := openTestDB(t)
+
+	for _, notes := range []string{"First read", "", "First read", "Second thoughts"} {
+		if err := db.SaveBook("Dune", "Frank Herbert", models.Paperback, notes, ""); err != nil {
+			t.Fatalf("Failed to save book: %v", err)
+		}
+		// created_at has one-second resolution, so space the books out
+		time.Sleep(1100 * time.Millisecond)
+	}
+	if err := db.SaveBook("Emma", "Jane Austen", models.Paperback, "", ""); err != nil {
+		t.Fatalf("Failed to save book: %v", err)
+	}
+
+	books, err := db.LoadBooks()
+	if err != nil {
+		t.Fatalf("Failed to load books: %v", err)
+	}
+	// LoadBooks is newest first, so the oldest Dune comes last
+	var dunes []models.Book
+	for _, book := range books {
+		if book.Title == "Dune" {
+			dunes = append(dunes, book)
+		}
+	}
+	oldest := dunes[len(dunes)-1]
+
+	// Keep the newest record to check the oldest created_at is carried over
+	keep := dunes[0]
+	var mergeIDs []int
+	for _, book := range dunes[1:] {
+		mergeIDs = append(mergeIDs, book.ID)
+	}
+	if err := db.MergeBooks(keep.ID, mergeIDs); err != nil {
+		t.Fatalf("MergeBooks() returned error: %v", err)
+	}
+
+	books, err = db.LoadBooks()
+	if err != nil {
+		t.Fatalf("Failed to load books: %v", err)
+	}
+	if len(books) != 2 {
+		t.Fatalf("Expected 2 books after merge, got %d", len(books))
+	}
+	var merged models.Book
+	for _, book := range books {
+		if book.ID == keep.ID {
+			merged = book
+		}
+	}
+	if !merged.CreatedAt.Equal(oldest.CreatedAt) {
+		t.Errorf("Merged CreatedAt = %v, want oldest %v", merged.CreatedAt, oldest.CreatedAt)
+	}
+	if want := "Second thoughts\n\nFirst read"; merged.Notes != want {
+		t.Errorf("Merged Notes = %q, want %q", merged.Notes, want)
+	}
+
+	// Merging into a book that is also listed to merge is rejected without changes
+	if err := db.MergeBooks(keep.ID, []int{keep.ID}); err == nil {
+		t.Error("MergeBooks() with the kept book in mergeIDs should return an error")
+	}
+	if err := db.MergeBooks(keep.ID, []int{9999}); err == nil {
+		t.Error("MergeBooks() with a missing book should return an error")
+	}
+	if count, err := db.GetBookCount(); err != nil || count != 2 {
+		t.Errorf("GetBookCount() after rejected merges = %d, %v, want 2", count, err)
+	}
+}
+
+// TestDatabase_MergeBooksKeepsLent tests that merging a lent duplicate into a book that is
+// not lent out keeps the borrower and date, and that a lent kept book keeps its own
+func TestDatabase_MergeBooksKeepsLent(t *testing.T) {
+	db := openTestDB(t)
+
+	for _, title := range []string{"Kept", "Lent Copy", "Other Copy", "Lent Kept"} {
+		if err := db.SaveBook(title, "Author", models.Paperback, "", ""); err != nil {
+			t.Fatalf("Failed to save book: %v", err)
+		}
+	}
+	books, err := db.LoadBooks()
+	if err != nil {
+		t.Fatalf("Failed to load books: %v", err)
+	}
+	ids := map[string]int{}
+	for _, book := range books {
+		ids[book.Title] = book.ID
+	}
+	if err := db.LendBook(ids["Lent Copy"], "Sam"); err != nil {
+		t.Fatalf("LendBook() returned error: %v", err)
+	}
+	lent, err := db.LoadLentBooks()
+	if err != nil || len(lent) != 1 {
+		t.Fatalf("LoadLentBooks() = %d books, %v, want 1", len(lent), err)
+	}
+	lentDate := lent[0].LentDate
+
+	if err := db.MergeBooks(ids["Kept"], []int{ids["Lent Copy"]}); err != nil {
+		t.Fatalf("MergeBooks() returned error: %v", err)
+	}
+	lent, err = db.LoadLentBooks()
+	if err != nil {
+		t.Fatalf("LoadLentBooks() returned error: %v", err)
+	}
+	if len(lent) != 1 || lent[0].ID != ids["Kept"] || lent[0].LentTo != "Sam" || !lent[0].LentDate.Equal(*lentDate) {
+		t.Errorf("Lent books after merge = %+v, want Kept lent to Sam on %v", lent, lentDate)
+	}
+
+	// A kept book that is already lent out keeps its own borrower
+	if err := db.LendBook(ids["Lent Kept"], "Alex"); err != nil {
+		t.Fatalf("LendBook() returned error: %v", err)
+	}
+	if err := db.MergeBooks(ids["Lent Kept"], []int{ids["Kept"], ids["Other Copy"]}); err != nil {
+		t.Fatalf("MergeBooks() returned error: %v", err)
+	}
+	lent, err = db.LoadLentBooks()
+	if err != nil {
+		t.Fatalf("LoadLentBooks() returned error: %v", err)
+	}
+	if len(lent) != 1 || lent[0].ID != ids["Lent Kept"] || lent[0].LentTo != "Alex" {
+		t.Errorf("Lent books after second merge = %+v, want only Lent Kept lent to Alex", lent)
+	}
+}
+
+// loadLocation returns the location of the book with the given ID
+func loadLocation(t *testing.T, db *database.DB, id int) string {
+	t.Helper()
+
+	books, err := db.LoadBooks()
+	if err != nil {
+		t.Fatalf("Failed to load books: %v", err)
+	}
+	for _, book := range books {
+		if book.ID == id {
+			return book.Location
+		}
+	}
+	t.Fatalf("Book %d not found", id)
+	return ""
+}
+
+// TestDatabase_MergeBooksLocationAndHistory tests that a kept book without a location takes
+// one from the merged records, and that its previous version is saved to its edit history
+func TestDatabase_MergeBooksLocationAndHistory(t *testing.T) {
+	db := openTestDB(t)
+
+	if err := db.SaveBook("Kept", "Author", models.Paperback, "Own notes", ""); err != nil {
+		t.Fatalf("Failed to save book: %v", err)
+	}
+	if err := db.SaveBook("Copy", "Author", models.Paperback, "Other notes", "Shelf B"); err != nil {
+		t.Fatalf("Failed to save book: %v", err)
+	}
+	if err := db.SaveBook("Shelved", "Author", models.Paperback, "", "Shelf A"); err != nil {
+		t.Fatalf("Failed to save book: %v", err)
+	}
+	books, err := db.LoadBooks()
+	if err != nil {
+		t.Fatalf("Failed to load books: %v", err)
+	}
+	ids := map[string]int{}
+	for _, book := range books {
+		ids[book.Title] = book.ID
+	}
+
+	if err := db.MergeBooks(ids["Kept"], []int{ids["Copy"]}); err != nil {
+		t.Fatalf("MergeBooks() returned error: %v", err)
+	}
+	if location := loadLocation(t, db, ids["Kept"]); location != "Shelf B" {
+		t.Errorf("Merged Location = %q, want %q", location, "Shelf B")
+	}
+	versions, err := db.LoadHistory(ids["Kept"])
+	if err != nil {
+		t.Fatalf("LoadHistory() returned error: %v", err)
+	}
+	if len(versions) != 1 || versions[0].Notes != "Own notes" || versions[0].Location != "" {
+		t.Errorf("History after merge = %+v, want the version with only its own notes", versions)
+	}
+
+	// A kept location is not replaced
+	if err := db.MergeBooks(ids["Shelved"], []int{ids["Kept"]}); err != nil {
+		t.Fatalf("MergeBooks() returned error: %v", err)
+	}
+	if location := loadLocation(t, db, ids["Shelved"]); location != "Shelf A" {
+		t.Errorf("Merged Location = %q, want the kept %q", location, "Shelf A")
+	}
+}
+
+// TestDatabase_Wishlist tests that new books are owned and that only books
+// moved to the wishlist are loaded and counted as wishlist books
+func TestDatabase_Wishlist(t *testing.T) {
+	db := openTestDB(t)
+
+	for _, title := range []string{"Owned Book", "Wanted Book"} {
+		if err := db.SaveBook(title, "Author", models.Paperback, "", ""); err != nil {
+			t.Fatalf("Failed to save book: %v", err)
+		}
+	}
+	books, err := db.LoadBooks()
+	if err != nil {
+		t.Fatalf("Failed to load books: %v", err)
+	}
+	var wantedID int
+	for _, book := range books {
+		if !book.Owned {
+			t.Errorf("New book %q should be owned", book.Title)
+		}
+		if book.Title == "Wanted Book" {
+			wantedID = book.ID
+		}
+	}
+
+	if err := db.SetOwned(wantedID, false); err != nil {
+		t.Fatalf("SetOwned() returned error: %v", err)
+	}
+	wishlist, err := db.LoadWishlist()
+	if err != nil {
+		t.Fatalf("LoadWishlist() returned error: %v", err)
+	}
+	if len(wishlist) != 1 || wishlist[0].Title != "Wanted Book" || wishlist[0].Owned {
+		t.Errorf("LoadWishlist() = %+v, want only Wanted Book", wishlist)
+	}
+	if count, err := db.GetWishlistCount(); err != nil || count != 1 {
+		t.Errorf("GetWishlistCount() = %d, %v, want 1", count, err)
+	}
+
+	// Buying the book moves it off the wishlist
+	if err := db.SetOwned(wantedID, true); err != nil {
+		t.Fatalf("SetOwned() returned error: %v", err)
+	}
+	if count, err := db.GetWishlistCount(); err != nil || count != 0 {
+		t.Errorf("GetWishlistCount() after marking owned = %d, %v, want 0", count, err)
+	}
+}
+
+// TestDatabase_LendBook tests that lending records the borrower and date,
+// that only lent books are loaded as lent, and that returning clears both
+func TestDatabase_LendBook(t *testing.T) {
+	db := openTestDB(t)
+
+	for _, title := range []string{"Shelved Book", "Lent Book"} {
+		if err := db.SaveBook(title, "Author", models.Paperback, "", ""); err != nil {
+			t.Fatalf("Failed to save book: %v", err)
+		}
+	}
+	books, err := db.LoadBooks()
+	if err != nil {
+		t.Fatalf("Failed to load books: %v", err)
+	}
+	var lentID int
+	for _, book := range books {
+		if book.LentTo != "" || book.LentDate != nil {
+			t.Errorf("New book %q should not be lent out", book.Title)
+		}
+		if book.Title == "Lent Book" {
+			lentID = book.ID
+		}
+	}
+
+	// A borrower name is required
+	for _, borrower := range []string{"", "   ", "Ana\tMaria"} {
+		if err := db.LendBook(lentID, borrower); err == nil {
+			t.Errorf("LendBook(%q) should have returned an error", borrower)
+		}
+	}
+
+	if err := db.LendBook(lentID, "  Ana  "); err != nil {
+		t.Fatalf("LendBook() returned error: %v", err)
+	}
+	lent, err := db.LoadLentBooks()
+	if err != nil {
+		t.Fatalf("LoadLentBooks() returned error: %v", err)
+	}
+	if len(lent) != 1 || lent[0].Title != "Lent Book" || lent[0].LentTo != "Ana" || lent[0].LentDate == nil {
+		t.Fatalf("LoadLentBooks() = %+v, want only Lent Book lent to Ana", lent)
+	}
+	if since := time.Since(*lent[0].LentDate); since < -time.Minute || since > time.Minute {
+		t.Errorf("LentDate = %v, want about now", *lent[0].LentDate)
+	}
+
+	// Returning the book clears the borrower and date
+	if err := db.ReturnBook(lentID); err != nil {
+		t.Fatalf("ReturnBook() returned error: %v", err)
+	}
+	lent, err = db.LoadLentBooks()
+	if err != nil {
+		t.Fatalf("LoadLentBooks() returned error: %v", err)
+	}
+	if len(lent) != 0 {
+		t.Errorf("LoadLentBooks() after return = %+v, want none", lent)
+	}
+	books, err = db.LoadBooks()
+	if err != nil {
+		t.Fatalf("Failed to load books: %v", err)
+	}
+	for _, book := range books {
+		if book.LentTo != "" || book.LentDate != nil {
+			t.Errorf("Returned book %q still lent: %q, %v", book.Title, book.LentTo, book.LentDate)
+		}
+	}
+}
+
+// TestDatabase_GetBooksAddedByYear tests that books are counted by the year they were added
+// and that years without additions are left out
+func TestDatabase_GetBooksAddedByYear(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "years.db")
+	db, err := database.New(dbPath)
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	years, err := db.GetBooksAddedByYear()
+	if err != nil {
+		t.Fatalf("GetBooksAddedByYear() on empty database returned error: %v", err)
+	}
+	if len(years) != 0 {
+		t.Errorf("GetBooksAddedByYear() on empty database = %v, want none", years)
+	}
+
+	for _, title := range []string{"Old", "Older", "Eve", "New"} {
+		if err := db.SaveBook(title, "Author", models.Paperback, "", ""); err != nil {
+			t.Fatalf("SaveBook() returned error: %v", err)
+		}
+	}
+
+	// Backdate two books, leaving a year with no additions between them
+	conn, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	defer conn.Close()
+	if _, err := conn.Exec("UPDATE books SET created_at = '2019-06-15 12:00:00' WHERE title = 'Older'"); err != nil {
+		t.Fatalf("Failed to backdate book: %v", err)
+	}
+	if _, err := conn.Exec("UPDATE books SET created_at = '2021-06-15 12:00:00' WHERE title = 'Old'"); err != nil {
+		t.Fatalf("Failed to backdate book: %v", err)
+	}
+	// Late on New Year's Eve counts toward the year shown in the list, whatever the local time zone
+	if _, err := conn.Exec("UPDATE books SET created_at = '2021-12-31 23:30:00' WHERE title = 'Eve'"); err != nil {
+		t.Fatalf("Failed to backdate book: %v", err)
+	}
+
+	years, err = db.GetBooksAddedByYear()
+	if err != nil {
+		t.Fatalf("GetBooksAddedByYear() returned error: %v", err)
+	}
+	want := map[int]int{2019: 1, 2021: 2, time.Now().UTC().Year(): 1}
+	if len(years) != len(want) {
+		t.Fatalf("GetBooksAddedByYear() = %v, want %v", years, want)
+	}
+	for year, count := range want {
+		if years[year] != count {
+			t.Errorf("GetBooksAddedByYear()[%d] = %d, want %d", year, years[year], count)
+		}
+	}
+}
+
+// TestOpenErrorHint tests that open failures are explained by their likely cause
+func TestOpenErrorHint(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string // Text the hint must contain
+	}{
+		{"permissions", errors.New("attempt to write a readonly database"), "permission"},
+		{"disk full", errors.New("database or disk is full"), "disk is full"},
+		{"corrupt file", errors.New("file is not a database"), "corrupt"},
+		{"cannot open", errors.New("unable to open database file: no such file or directory"), "could not be opened"},
+		{"unknown", errors.New("something unexpected"), "books.db.bak"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := database.OpenErrorHint(tt.err); !strings.Contains(got, tt.want) {
+				t.Errorf("OpenErrorHint(%q) = %q, want it to mention %q", tt.err, got, tt.want)
+			}
+		})
+	}
+
+	// A file that is not SQLite is reported as corrupt when opened
+	dbPath := filepath.Join(t.TempDir(), "books.db")
+	if err := os.WriteFile(dbPath, []byte("this is not a sqlite database, just some text padding it out"), 0644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+	_, err := database.New(dbPath)
+	if err == nil {
+		t.Fatal("New() on a corrupt file should return an error")
+	}
+	if hint := database.OpenErrorHint(err); !strings.Contains(hint, "corrupt") {
+		t.Errorf("OpenErrorHint(%q) = %q, want corrupt file advice", err, hint)
+	}
+}
+
+// TestDatabase_ConcurrentAccess tests that saves and loads running in parallel
+// goroutines, as Bubble Tea commands do, all succeed without locking errors
+func TestDatabase_ConcurrentAccess(t *testing.T) {
+	db := openTestDB(t)
+
+	const workers = 20
+	var wg sync.WaitGroup
+	errs := make(chan error, workers*2)
+
+	for i := 0; i < workers; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			errs <- db.SaveBook(fmt.Sprintf("Book %d", i), "Author", models.Paperback, "", "")
+		}(i)
+		go func() {
+			defer wg.Done()
+			_, err := db.LoadBooks()
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Errorf("Concurrent operation returned error: %v", err)
+		}
+	}
+
+	count, err := db.GetBookCount()
+	if err != nil {
+		t.Fatalf("GetBookCount() returned error: %v", err)
+	}
+	if count != workers {
+		t.Errorf("GetBookCount() = %d, want %d", count, workers)
+	}
+}
+
+// TestDatabase_GetStatistics tests that statistics reflect the stored books and their notes
+func TestDatabase_GetStatistics(t *testing.T) {
+	db := openTestDB(t)
+
+	stats, err := db.GetStatistics()
+	if err != nil {
+		t.Fatalf("GetStatistics() on empty database returned error: %v", err)
+	}
+	if stats.TotalBooks != 0 || stats.BooksWithNotes != 0 {
+		t.Errorf("GetStatistics() on empty database = %+v, want zeros", stats)
+	}
+
+	if err := db.SaveBook("Noted", "Author", models.Paperback, "a short note here", ""); err != nil {
+		t.Fatalf("SaveBook() returned error: %v", err)
+	}
+	if err := db.SaveBook("Unnoted", "Author", models.Paperback, "", ""); err != nil {
+		t.Fatalf("SaveBook() returned error: %v", err)
+	}
+
+	stats, err = db.GetStatistics()
+	if err != nil {
+		t.Fatalf("GetStatistics() returned error: %v", err)
+	}
+	if stats.TotalBooks != 2 || stats.BooksWithNotes != 1 || stats.NoteWords != 4 {
+		t.Errorf("GetStatistics() = %+v, want 2 books, 1 with notes, 4 words", stats)
+	}
+}
@@ -28,7 +28,7 @@ func TestDatabaseOperations(t *testing.T) {
 	title := "Test Book"
 	author := "Test Author"
 	
-	err = db.SaveBook(title, author, models.Paperback, "")
+	err = db.SaveBook(title, author, models.Paperback, "", "")
 	if err != nil {
 		t.Fatalf("Failed to save book: %v", err)
 	}
@@ -54,7 +54,7 @@ func TestDatabaseOperations(t *testing.T) {
 	newTitle := "Updated Test Book"
 	newAuthor := "Updated Test Author"
 	
-	err = db.UpdateBook(book.ID, newTitle, newAuthor, models.Hardback, "")
+	err = db.UpdateBook(book.ID, newTitle, newAuthor, models.Hardback, "", "")
 	if err != nil {
 		t.Fatalf("Failed to update book: %v", err)
 	}
@@ -108,25 +108,25 @@ func TestSaveBookValidation(t *testing.T) {
 	defer db.Close()
 
 	// Test validation: both title and author are empty (should fail)
-	err = db.SaveBook("", "", models.Paperback, "")
+	err = db.SaveBook("", "", models.Paperback, "", "")
 	if err == nil {
 		t.Error("Expected validation error for empty fields")
 	}
 
 	// Test validation: empty title with valid author (should fail)
-	err = db.SaveBook("", "Valid Author", models.Paperback, "")
+	err = db.SaveBook("", "Valid Author", models.Paperback, "", "")
 	if err == nil {
 		t.Error("Expected validation error for empty title")
 	}
 
 	// Test validation: valid title with empty author (should fail)
-	err = db.SaveBook("Valid Title", "", models.Paperback, "")
+	err = db.SaveBook("Valid Title", "", models.Paperback, "", "")
 	if err == nil {
 		t.Error("Expected validation error for empty author")
 	}
 
 	// Test validation: both title and author are valid (should succeed)
-	err = db.SaveBook("Valid Title", "Valid Author", models.Paperback, "")
+	err = db.SaveBook("Valid Title", "Valid Author", models.Paperback, "", "")
 	if err != nil {
 		t.Errorf("Expected no error for valid input, got: %v", err)
 	}
@@ -157,7 +157,7 @@ func TestBookCount(t *testing.T) {
 	}
 
 	// Add a book to the database
-	err = db.SaveBook("Test Title", "Test Author", models.Paperback, "")
+	err = db.SaveBook("Test Title", "Test Author", models.Paperback, "", "")
 	if err != nil {
 		t.Fatalf("Failed to save book: %v", err)
 	}
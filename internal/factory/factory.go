@@ -3,7 +3,9 @@ package factory
 import (
 	"github.com/charmbracelet/bubbles/textarea"
 	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/papadavis47/libros/internal/config"
 	"github.com/papadavis47/libros/internal/constants"
+	"github.com/papadavis47/libros/internal/i18n"
 	"github.com/papadavis47/libros/internal/styles"
 )
 
@@ -23,7 +25,7 @@ func CreateTitleInput() textinput.Model {
 	ti.CharLimit = constants.TitleMaxLength
 	ti.Width = constants.InputFieldWidth
 	ti.Placeholder = "_______________"
-	ti.Prompt = "   " + styles.AddLetterSpacing("Title:") + "  "
+	ti.Prompt = "   " + styles.AddLetterSpacing(i18n.T(i18n.FormTitle)) + "  "
 	ti.Focus() // Start focused
 	ti.PromptStyle = styles.FormFocusedStyle()
 	ti.TextStyle = styles.FormFocusedStyle()
@@ -36,24 +38,60 @@ func CreateAuthorInput() textinput.Model {
 	ti.CharLimit = constants.AuthorMaxLength
 	ti.Width = constants.InputFieldWidth
 	ti.Placeholder = "_______________"
-	ti.Prompt = "   " + styles.AddLetterSpacing("Author:") + "  "
+	ti.Prompt = "   " + styles.AddLetterSpacing(i18n.T(i18n.FormAuthor)) + "  "
 	ti.PromptStyle = styles.NoStyle // Remove purple styling to prevent double padding
+	ti.ShowSuggestions = true       // Suggest existing authors; Tab accepts
+	return ti
+}
+
+// CreateLocationInput creates a text input for where a physical copy is kept
+func CreateLocationInput() textinput.Model {
+	ti := textinput.New()
+	ti.CharLimit = constants.LocationMaxLength
+	ti.Width = constants.InputFieldWidth
+	ti.Placeholder = "_______________ " + i18n.T(i18n.FormOptional)
+	ti.Prompt = "   " + styles.AddLetterSpacing(i18n.T(i18n.FormLocation)) + "  "
+	ti.PromptStyle = styles.NoStyle
 	return ti
 }
 
 // CreateNotesTextArea creates a standardized textarea for book notes
 func CreateNotesTextArea() textarea.Model {
 	ta := textarea.New()
-	ta.Placeholder = "Notes about this book (optional)..."
-	ta.CharLimit = constants.NotesMaxLength
+	ta.Placeholder = i18n.T(i18n.FormNotesPlaceholder)
+	ta.CharLimit = config.GetNotesMaxLength() // User-configurable, defaults to NotesMaxLength
 	ta.SetWidth(constants.InputFieldWidth)
-	ta.SetHeight(4)
+	ta.SetHeight(config.GetNotesHeight()) // User-adjustable with Ctrl+Up/Ctrl+Down
 	ta.ShowLineNumbers = false
 	ta.Prompt = "   " // 3-space left padding for alignment
 	// Note: Custom styles can be applied from the calling screen if needed
 	return ta
 }
 
+// CreateBulkEntryTextArea creates a textarea for pasting one "Title | Author | Type" per line
+// Line numbers are shown so malformed rows in the preview can be found again
+func CreateBulkEntryTextArea() textarea.Model {
+	ta := textarea.New()
+	ta.Placeholder = "Dune | Frank Herbert | Hardback"
+	ta.CharLimit = constants.BulkEntryMaxLength
+	ta.SetWidth(constants.TextAreaWidth)
+	ta.SetHeight(constants.BulkEntryHeight)
+	ta.ShowLineNumbers = true
+	ta.Prompt = "   " // 3-space left padding for alignment
+	return ta
+}
+
+// CreatePassphraseInput creates a text input that masks what is typed, for encryption passphrases
+func CreatePassphraseInput(placeholder string) textinput.Model {
+	ti := textinput.New()
+	ti.Placeholder = placeholder
+	ti.Width = constants.InputFieldWidth
+	ti.EchoMode = textinput.EchoPassword
+	ti.EchoCharacter = '•'
+	ti.Prompt = "   " // 3-space left padding for alignment
+	return ti
+}
+
 // CreatePathInput creates a text input for file paths (used in export screen)
 func CreatePathInput(placeholder string) textinput.Model {
 	ti := textinput.New()
@@ -3,9 +3,18 @@ package factory
 import (
 	"testing"
 
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/papadavis47/libros/internal/config"
 	"github.com/papadavis47/libros/internal/constants"
 )
 
+// useTempHome points the config file at a temporary home directory, so factories
+// that read the configuration never touch the developer's real ~/.libros
+func useTempHome(tb testing.TB) {
+	tb.Helper()
+	tb.Setenv("HOME", tb.TempDir())
+}
+
 // TestCreateTextInput tests the generic text input factory function
 // This function creates standardized text inputs with consistent configuration
 func TestCreateTextInput(t *testing.T) {
@@ -37,6 +46,7 @@ func TestCreateTextInput(t *testing.T) {
 // TestCreateTitleInput tests the title-specific input factory function
 // This function creates inputs with title-specific styling and validation limits
 func TestCreateTitleInput(t *testing.T) {
+	useTempHome(t)
 	input := CreateTitleInput()
 	
 	// Test title-specific properties
@@ -63,6 +73,7 @@ func TestCreateTitleInput(t *testing.T) {
 // TestCreateAuthorInput tests the author-specific input factory function
 // This function creates inputs with author-specific styling and validation limits
 func TestCreateAuthorInput(t *testing.T) {
+	useTempHome(t)
 	input := CreateAuthorInput()
 	
 	// Test author-specific properties
@@ -84,14 +95,43 @@ func TestCreateAuthorInput(t *testing.T) {
 	if input.Focused() {
 		t.Error("CreateAuthorInput() should not create a focused input")
 	}
+
+	// Test that author autocomplete suggestions are enabled
+	if !input.ShowSuggestions {
+		t.Error("CreateAuthorInput() should enable suggestions for author autocomplete")
+	}
+}
+
+// TestCreateLocationInput tests the location-specific input factory function
+// Location is optional, so the input starts unfocused with a length limit
+func TestCreateLocationInput(t *testing.T) {
+	useTempHome(t)
+	input := CreateLocationInput()
+
+	if input.CharLimit != constants.LocationMaxLength {
+		t.Errorf("CreateLocationInput() CharLimit = %d, want %d", input.CharLimit, constants.LocationMaxLength)
+	}
+
+	if input.Width != constants.InputFieldWidth {
+		t.Errorf("CreateLocationInput() Width = %d, want %d", input.Width, constants.InputFieldWidth)
+	}
+
+	if !containsPromptPadding(input.Prompt) {
+		t.Error("CreateLocationInput() should have consistent prompt padding")
+	}
+
+	if input.Focused() {
+		t.Error("CreateLocationInput() should not create a focused input")
+	}
 }
 
 // TestCreateNotesTextArea tests the notes-specific textarea factory function
 // This function creates textareas optimized for longer text input
 func TestCreateNotesTextArea(t *testing.T) {
+	useTempHome(t)
 	textarea := CreateNotesTextArea()
 	
-	// Test notes-specific properties
+	// Test notes-specific properties; with no config file the default limit applies
 	if textarea.CharLimit != constants.NotesMaxLength {
 		t.Errorf("CreateNotesTextArea() CharLimit = %d, want %d", textarea.CharLimit, constants.NotesMaxLength)
 	}
@@ -102,7 +142,7 @@ func TestCreateNotesTextArea(t *testing.T) {
 		t.Errorf("CreateNotesTextArea() Width = %d, should be between 40 and 60", textarea.Width())
 	}
 	
-	expectedHeight := 4
+	expectedHeight := constants.NotesHeight // No config file, so the default height
 	if textarea.Height() != expectedHeight {
 		t.Errorf("CreateNotesTextArea() Height = %d, want %d", textarea.Height(), expectedHeight)
 	}
@@ -125,6 +165,21 @@ func TestCreateNotesTextArea(t *testing.T) {
 	}
 }
 
+// TestCreateNotesTextArea_ConfiguredLimit tests that a notes_max_length set in the
+// config file becomes the notes textarea's character limit
+func TestCreateNotesTextArea_ConfiguredLimit(t *testing.T) {
+	useTempHome(t)
+	cfg := config.DefaultConfig()
+	cfg.NotesMaxLength = 5000
+	if err := config.SaveConfig(cfg); err != nil {
+		t.Fatalf("SaveConfig() returned error: %v", err)
+	}
+
+	if textarea := CreateNotesTextArea(); textarea.CharLimit != 5000 {
+		t.Errorf("CreateNotesTextArea() CharLimit = %d, want the configured 5000", textarea.CharLimit)
+	}
+}
+
 // TestCreatePathInput tests the path-specific input factory function
 // This function creates inputs optimized for file path entry
 func TestCreatePathInput(t *testing.T) {
@@ -148,9 +203,54 @@ func TestCreatePathInput(t *testing.T) {
 	}
 }
 
+// TestCreateBulkEntryTextArea tests the textarea used to paste several books at once
+func TestCreateBulkEntryTextArea(t *testing.T) {
+	textarea := CreateBulkEntryTextArea()
+
+	if textarea.CharLimit != constants.BulkEntryMaxLength {
+		t.Errorf("CreateBulkEntryTextArea() CharLimit = %d, want %d", textarea.CharLimit, constants.BulkEntryMaxLength)
+	}
+
+	if textarea.Height() != constants.BulkEntryHeight {
+		t.Errorf("CreateBulkEntryTextArea() Height = %d, want %d", textarea.Height(), constants.BulkEntryHeight)
+	}
+
+	// Line numbers help find a malformed row reported in the preview
+	if !textarea.ShowLineNumbers {
+		t.Error("CreateBulkEntryTextArea() should show line numbers")
+	}
+
+	if textarea.Focused() {
+		t.Error("CreateBulkEntryTextArea() should not create a focused textarea")
+	}
+}
+
+// TestCreatePassphraseInput tests the passphrase input factory function
+// Passphrases must never be shown on screen as they are typed
+func TestCreatePassphraseInput(t *testing.T) {
+	input := CreatePassphraseInput("Passphrase")
+
+	if input.Placeholder != "Passphrase" {
+		t.Errorf("CreatePassphraseInput() Placeholder = %q, want %q", input.Placeholder, "Passphrase")
+	}
+
+	if input.EchoMode != textinput.EchoPassword {
+		t.Error("CreatePassphraseInput() should mask the typed passphrase")
+	}
+
+	if input.Prompt != "   " {
+		t.Errorf("CreatePassphraseInput() Prompt = %q, want %q", input.Prompt, "   ")
+	}
+
+	if input.Focused() {
+		t.Error("CreatePassphraseInput() should not create a focused input")
+	}
+}
+
 // TestFactory_Consistency tests that factory functions create consistent components
 // This ensures all factory functions follow the same patterns and conventions
 func TestFactory_Consistency(t *testing.T) {
+	useTempHome(t)
 	titleInput := CreateTitleInput()
 	authorInput := CreateAuthorInput()
 	pathInput := CreatePathInput("test")
@@ -198,6 +298,7 @@ func TestFactory_Consistency(t *testing.T) {
 // TestFactory_InputStates tests that factory functions create inputs in appropriate states
 // This ensures proper focus management and initial configuration
 func TestFactory_InputStates(t *testing.T) {
+	useTempHome(t)
 	titleInput := CreateTitleInput()
 	authorInput := CreateAuthorInput()
 	
@@ -222,6 +323,7 @@ func containsPromptPadding(prompt string) bool {
 // BenchmarkCreateTitleInput benchmarks the title input creation
 // This ensures factory function performance is acceptable
 func BenchmarkCreateTitleInput(b *testing.B) {
+	useTempHome(b)
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		CreateTitleInput()
@@ -231,6 +333,7 @@ func BenchmarkCreateTitleInput(b *testing.B) {
 // BenchmarkCreateNotesTextArea benchmarks the notes textarea creation
 // This ensures factory function performance is acceptable
 func BenchmarkCreateNotesTextArea(b *testing.B) {
+	useTempHome(b)
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		CreateNotesTextArea()
@@ -0,0 +1,211 @@
+// Package help holds the help line shown at the foot of each screen
+// Defaults match the application's built-in wording, and individual lines
+// can be replaced from the [help] section of the configuration file, for
+// example to shorten or translate them
+package help
+
+import (
+	"github.com/papadavis47/libros/internal/config"
+	"github.com/papadavis47/libros/internal/i18n"
+)
+
+// ID names a help line, usually a screen or one state of a screen
+// The string value is the name used in the [help] config section
+type ID string
+
+// Main menu, utilities and other navigation screens
+const (
+	Menu            ID = "menu"
+	MenuConfigError ID = "menu.config_error"
+	Utilities       ID = "utilities"
+	Theme           ID = "theme"
+	Library         ID = "library"
+	Palette         ID = "palette"
+)
+
+// Add and edit forms
+const (
+	Add        ID = "add"
+	AddType    ID = "add.type"
+	AddReview  ID = "add.review"
+	AddRestore ID = "add.restore"
+	Edit       ID = "edit"
+	EditType   ID = "edit.type"
+)
+
+// Book list, details and history
+const (
+	List              ID = "list"
+	ListEmpty         ID = "list.empty"
+	ListRetype        ID = "list.retype"
+	ListFilter        ID = "list.filter"
+	ListFilterNoMatch ID = "list.filter_no_match"
+	ListFiltered      ID = "list.filtered"
+	ListDate          ID = "list.date"
+	ListDated         ID = "list.dated"
+	Detail            ID = "detail"
+	DetailLend        ID = "detail.lend"
+	History           ID = "history"
+	HistoryEmpty      ID = "history.empty"
+	HistoryConfirm    ID = "history.confirm"
+)
+
+// Utilities screens
+const (
+	BackupConfirm       ID = "backup.confirm"
+	BackupDone          ID = "backup.done"
+	Stats               ID = "stats"
+	Duplicates          ID = "duplicates"
+	Attention           ID = "attention"
+	AttentionBooks      ID = "attention.books"
+	Normalize           ID = "normalize"
+	NormalizeDone       ID = "normalize.done"
+	Validate            ID = "validate"
+	ValidateRunning     ID = "validate.running"
+	Integrity           ID = "integrity"
+	IntegrityRunning    ID = "integrity.running"
+	DBInfo              ID = "dbinfo"
+	Clear               ID = "clear"
+	ClearEmpty          ID = "clear.empty"
+	Import              ID = "import"
+	ImportPassphrase    ID = "import.passphrase"
+	ImportPreview       ID = "import.preview"
+	ImportPreviewEmpty  ID = "import.preview_empty"
+	ImportResult        ID = "import.result"
+	BulkAdd             ID = "bulkadd"
+	BulkAddPreview      ID = "bulkadd.preview"
+	BulkAddPreviewEmpty ID = "bulkadd.preview_empty"
+	BulkAddResult       ID = "bulkadd.result"
+	Verify              ID = "verify"
+	VerifyResult        ID = "verify.result"
+)
+
+// Export screen steps
+const (
+	ExportPath        ID = "export.path"
+	ExportDates       ID = "export.dates"
+	ExportFormat      ID = "export.format"
+	ExportFields      ID = "export.fields"
+	ExportPassphrase  ID = "export.passphrase"
+	ExportRunning     ID = "export.running"
+	ExportResult      ID = "export.result"
+	ExportResultError ID = "export.result_error"
+)
+
+// typeSelector is the start of the help shown while a book type selector is focused
+const typeSelector = "Press p/h/a/d for Paperback/Hardback/Audio/Digital, ←/→ or Tab to cycle"
+
+// Lines holds the help text for each line
+type Lines map[ID]string
+
+// Default returns the built-in help lines
+func Default() Lines {
+	return Lines{
+		Menu:            "Use ↑/↓ or j/k to navigate, Enter to select, q or Ctrl+C to quit",
+		MenuConfigError: "Press r to reset it to defaults, or any other key to continue",
+		Utilities:       "Use ↑/↓ or j/k to navigate, Enter to select, q or Ctrl+C to quit",
+		Theme:           "Use ↑/↓ or j/k to navigate, Enter to select, Esc to return to menu",
+		Library:         "Use ↑/↓ or j/k to navigate, Enter to switch, Esc to go back",
+		Palette:         "Type to search, ↑/↓ to choose, Enter to open, Esc to close",
+
+		Add:        "Press Esc to return to menu, Ctrl+A/Ctrl+E for start/end of field, Ctrl+U to clear it, Ctrl+↑/↓ to resize notes, q or Ctrl+C to quit",
+		AddType:    typeSelector + ", Esc to return to menu, Ctrl+C to quit",
+		AddReview:  "Press y to save, n or Esc to keep editing",
+		AddRestore: "Press y to restore the draft, n to discard it",
+		Edit:       "Press Esc to cancel, Ctrl+A/Ctrl+E for start/end of field, Ctrl+U to clear it, Ctrl+↑/↓ to resize notes, q or Ctrl+C to quit",
+		EditType:   typeSelector + ", Esc to cancel, Ctrl+C to quit",
+
+		List:              "Use ↑/↓ or j/k to navigate, ←/→ to page, Enter to select, Space to expand notes, / to filter, d to filter by date added, s/S to sort, g to group by location, x to mark, t to change type of marked, Esc to return to menu, q to quit",
+		ListEmpty:         "Press Esc to return to menu, q or Ctrl+C to quit",
+		ListRetype:        typeSelector + ", Enter to apply, Esc to cancel",
+		ListFilter:        "Type to filter by title, author or notes, Enter to apply, Esc to cancel",
+		ListFilterNoMatch: "Keep typing to change the search, Esc to cancel",
+		ListFiltered:      "Use ↑/↓ or j/k to navigate, Enter to select, / to filter again, Esc to clear filter, q to quit",
+		ListDate:          "Type a date as YYYY-MM-DD, Tab to switch before/after, Enter to apply (blank to clear), Esc to cancel",
+		ListDated:         "Use ↑/↓ or j/k to navigate, Enter to select, / to filter, d to change the date, Esc to clear the date filter, q to quit",
+		Detail:            "Use ↑/↓ or j/k to navigate, Enter to select, n/p for next/previous book, c/m to copy an APA/MLA citation, Tab to scroll long notes, Esc to go back, q to quit",
+		DetailLend:        "Type the borrower's name, Enter to lend the book, Esc to cancel",
+		History:           "Use ↑/↓ or j/k to navigate, Enter to restore a version, Esc to go back",
+		HistoryEmpty:      "Press Esc to go back",
+		HistoryConfirm:    "Press y to restore, n or Esc to cancel",
+
+		BackupConfirm:       "Press y to overwrite, Esc to cancel",
+		BackupDone:          "Press Enter or Esc to return to Utilities",
+		Stats:               "Press y to show or hide years with no books added, Enter or Esc to return to Utilities",
+		Duplicates:          "Use ↑/↓ or j/k to choose a group, Enter to merge it, Esc to return to Utilities",
+		Attention:           "Use ↑/↓ or j/k to choose a check, Enter to list its books, Esc to return to Utilities",
+		AttentionBooks:      "Use ↑/↓ or j/k to navigate, Enter to edit the book, Esc to go back to the checks",
+		Normalize:           "Tab to switch fields, Enter to save (blank fields get the placeholder), Ctrl+N to skip, Esc to stop",
+		NormalizeDone:       "Press Enter or Esc to return to Utilities",
+		Validate:            "Press Enter or Esc to return to Utilities",
+		ValidateRunning:     "Esc to go back",
+		Integrity:           "Press Enter or Esc to return to Utilities",
+		IntegrityRunning:    "Esc to go back",
+		DBInfo:              "Press Enter or Esc to return to Utilities",
+		Clear:               "Enter to confirm, Esc to cancel",
+		ClearEmpty:          "Press Enter or Esc to return to Utilities",
+		Import:              "Enter to preview, Esc to go back",
+		ImportPassphrase:    "Enter to preview, Esc to choose another file",
+		ImportPreview:       "Press y to import, n or Esc to choose another file",
+		ImportPreviewEmpty:  "Nothing to import. Press Esc to choose another file",
+		ImportResult:        "Press Enter or Esc to return to Utilities",
+		BulkAdd:             "Ctrl+S to preview, Esc to go back",
+		BulkAddPreview:      "Press y to add the valid books, n or Esc to edit the lines",
+		BulkAddPreviewEmpty: "Nothing to add. Press n or Esc to edit the lines",
+		BulkAddResult:       "Press Enter or Esc to return to Utilities",
+		Verify:              "Enter to verify, Esc to go back",
+		VerifyResult:        "Press v to verify another file, Enter or Esc to return to Utilities",
+
+		ExportPath:        "Enter to continue, Esc to go back",
+		ExportDates:       "Tab to switch fields, Enter to continue, Esc to go back",
+		ExportFormat:      "Use ↑/↓ or j/k to navigate, Enter to select, c to toggle checksum, Esc to go back",
+		ExportFields:      "Use ↑/↓ or j/k to navigate, Space to include or leave out, Enter to export, Esc to go back",
+		ExportPassphrase:  "Tab to switch fields, Enter to export, Esc to go back",
+		ExportRunning:     "Esc to go back",
+		ExportResult:      "Press o to open the folder, Enter or Esc to continue",
+		ExportResultError: "Press Enter or Esc to continue",
+	}
+}
+
+// New returns the default help lines with the given overrides applied
+// Unknown names and blank text are ignored so a partial or mistyped
+// config never leaves a screen without help
+func New(overrides map[string]string) Lines {
+	return Default().override(overrides)
+}
+
+// Translate replaces each line that the language's catalog translates, under
+// the key "help." followed by the line's ID, and returns the lines
+// Lines without a translation keep their English text
+func (l Lines) Translate(lang string) Lines {
+	for id := range l {
+		if text, ok := i18n.Lookup(lang, i18n.Key("help."+string(id))); ok {
+			l[id] = text
+		}
+	}
+	return l
+}
+
+// override replaces the lines named in overrides and returns the lines
+func (l Lines) override(overrides map[string]string) Lines {
+	for name, text := range overrides {
+		id := ID(name)
+		if _, ok := l[id]; !ok || text == "" {
+			continue
+		}
+		l[id] = text
+	}
+	return l
+}
+
+// Load returns the help lines in the configured language, with the
+// configured overrides applied on top
+func Load() Lines {
+	return Default().Translate(config.Cached().Language).override(config.Cached().Help)
+}
+
+// Text returns the current help line for id, read from the cached settings
+// Screens call it as they render, so a saved config applies on the next frame
+func Text(id ID) string {
+	return Load()[id]
+}
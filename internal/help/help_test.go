@@ -0,0 +1,70 @@
+package help
+
+import "testing"
+
+// TestDefault_HasText tests that every built-in help line has text
+func TestDefault_HasText(t *testing.T) {
+	for id, text := range Default() {
+		if text == "" {
+			t.Errorf("Default()[%q] is empty", id)
+		}
+	}
+
+	lines := Default()
+	if got, want := lines[Menu], "Use ↑/↓ or j/k to navigate, Enter to select, q or Ctrl+C to quit"; got != want {
+		t.Errorf("Default()[Menu] = %q, want %q", got, want)
+	}
+	if got, want := lines[ListRetype], typeSelector+", Enter to apply, Esc to cancel"; got != want {
+		t.Errorf("Default()[ListRetype] = %q, want %q", got, want)
+	}
+}
+
+// TestNew_AppliesOverrides tests merging configured help lines over the defaults
+func TestNew_AppliesOverrides(t *testing.T) {
+	lines := New(map[string]string{
+		"menu":   "↑/↓ move, Enter opens, q quits",
+		"detail": "",        // Blank text keeps the default
+		"launch": "Press x", // Unknown names are ignored
+	})
+	defaults := Default()
+
+	tests := []struct {
+		name string
+		id   ID
+		want string
+	}{
+		{"override replaces default", Menu, "↑/↓ move, Enter opens, q quits"},
+		{"untouched line keeps default", Utilities, defaults[Utilities]},
+		{"blank override keeps default", Detail, defaults[Detail]},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := lines[tt.id]; got != tt.want {
+				t.Errorf("New()[%q] = %q, want %q", tt.id, got, tt.want)
+			}
+		})
+	}
+	if _, ok := lines[ID("launch")]; ok {
+		t.Error("New() should ignore unknown help lines")
+	}
+	if len(New(nil)) != len(defaults) {
+		t.Errorf("New(nil) has %d lines, want %d", len(New(nil)), len(defaults))
+	}
+}
+
+// TestLines_Translate tests that translated help lines replace the English ones
+// and that lines the catalog leaves out keep their English text
+func TestLines_Translate(t *testing.T) {
+	defaults := Default()
+	lines := Default().Translate("es")
+
+	if lines[Menu] == defaults[Menu] {
+		t.Errorf("Translate(es)[Menu] = %q, want the Spanish text", lines[Menu])
+	}
+	if lines[Stats] != defaults[Stats] {
+		t.Errorf("Translate(es)[Stats] = %q, want the English text %q", lines[Stats], defaults[Stats])
+	}
+	if lines := Default().Translate("fr"); lines[Menu] != defaults[Menu] {
+		t.Errorf("Translate(fr)[Menu] = %q, want the English text", lines[Menu])
+	}
+}
@@ -0,0 +1,46 @@
+package i18n
+
+// english is the built-in text, and the fallback for every other language
+// Help lines are kept in the help package, so they are not repeated here
+var english = Catalog{
+	AppTitle: "Ｌｉｂｒｏｓ　－　Ａ　Ｂｏｏｋ　Ｍａｎａｇｅｒ",
+
+	MenuAdd:               "Ａｄｄ　Ｂｏｏｋ",
+	MenuView:              "Ｖｉｅｗ　Ｂｏｏｋｓ",
+	MenuUtilities:         "Ｕｔｉｌｉｔｉｅｓ",
+	MenuTheme:             "Ｔｈｅｍｅ",
+	MenuQuit:              "Ｑｕｉｔ",
+	MenuLibrary:           "Library: %s",
+	MenuReading:           "📖 Currently reading: %s",
+	MenuWishlist:          "Wishlist: %d book(s)",
+	MenuBooks:             "%d books",
+	MenuOneBook:           "1 book",
+	MenuOneReading:        "1 reading",
+	MenuConfigUnreadable:  "Config file is unreadable, using defaults: %v",
+	MenuConfigResetFailed: "Failed to reset config: %v",
+	MenuConfigReset:       "Config reset to defaults",
+
+	FormAddTitle:         "Ａｄｄ　Ｎｅｗ　Ｂｏｏｋ",
+	FormEditTitle:        "Ｅｄｉｔ　Ｂｏｏｋ",
+	FormTitle:            "Title:",
+	FormAuthor:           "Author:",
+	FormLocation:         "Location:",
+	FormType:             "Type:",
+	FormNotes:            "Notes:",
+	FormOptional:         "(optional)",
+	FormNotesPlaceholder: "Notes about this book (optional)...",
+	FormSave:             "SAVE BOOK",
+	FormUpdate:           "UPDATE BOOK",
+	FormError:            "Error: %v",
+	FormSaved:            "Book saved successfully!",
+	FormSession:          "Rapid entry · Books added this session: %d",
+	FormReview:           "Review before saving",
+	FormRestore:          "Restore unsaved draft? y/n",
+	FormDuplicate:        "Another book with this title and author already exists.",
+	FormDuplicateConfirm: "Press Enter again to save anyway, or change the title/author.",
+
+	TypePaperback: "Paperback",
+	TypeHardback:  "Hardback",
+	TypeAudio:     "Audio",
+	TypeDigital:   "Digital",
+}
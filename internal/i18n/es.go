@@ -0,0 +1,55 @@
+package i18n
+
+// spanish translates the menu, the add and edit forms and their help lines
+// Anything else falls back to English
+var spanish = Catalog{
+	AppTitle: "Ｌｉｂｒｏｓ　－　Ｇｅｓｔｏｒ　ｄｅ　Ｌｉｂｒｏｓ",
+
+	MenuAdd:               "Ａｇｒｅｇａｒ　Ｌｉｂｒｏ",
+	MenuView:              "Ｖｅｒ　Ｌｉｂｒｏｓ",
+	MenuUtilities:         "Ｕｔｉｌｉｄａｄｅｓ",
+	MenuTheme:             "Ｔｅｍａ",
+	MenuQuit:              "Ｓａｌｉｒ",
+	MenuLibrary:           "Biblioteca: %s",
+	MenuReading:           "📖 Leyendo ahora: %s",
+	MenuWishlist:          "Lista de deseos: %d libro(s)",
+	MenuBooks:             "%d libros",
+	MenuOneBook:           "1 libro",
+	MenuOneReading:        "1 en lectura",
+	MenuConfigUnreadable:  "No se puede leer el archivo de configuración, se usan los valores predeterminados: %v",
+	MenuConfigResetFailed: "No se pudo restablecer la configuración: %v",
+	MenuConfigReset:       "Configuración restablecida",
+
+	FormAddTitle:         "Ａｇｒｅｇａｒ　Ｎｕｅｖｏ　Ｌｉｂｒｏ",
+	FormEditTitle:        "Ｅｄｉｔａｒ　Ｌｉｂｒｏ",
+	FormTitle:            "Título:",
+	FormAuthor:           "Autor:",
+	FormLocation:         "Ubicación:",
+	FormType:             "Tipo:",
+	FormNotes:            "Notas:",
+	FormOptional:         "(opcional)",
+	FormNotesPlaceholder: "Notas sobre este libro (opcional)...",
+	FormSave:             "GUARDAR LIBRO",
+	FormUpdate:           "ACTUALIZAR LIBRO",
+	FormError:            "Error: %v",
+	FormSaved:            "¡Libro guardado!",
+	FormSession:          "Entrada rápida · Libros agregados en esta sesión: %d",
+	FormReview:           "Revisar antes de guardar",
+	FormRestore:          "¿Restaurar el borrador sin guardar? y/n",
+	FormDuplicate:        "Ya existe otro libro con este título y autor.",
+	FormDuplicateConfirm: "Presiona Enter otra vez para guardarlo igualmente, o cambia el título o el autor.",
+
+	TypePaperback: "Rústica",
+	TypeHardback:  "Tapa dura",
+	TypeAudio:     "Audio",
+	TypeDigital:   "Digital",
+
+	"help.menu":              "Usa ↑/↓ o j/k para moverte, Enter para elegir, q o Ctrl+C para salir",
+	"help.menu.config_error": "Presiona r para restablecerlo, o cualquier otra tecla para continuar",
+	"help.add":               "Presiona Esc para volver al menú, Ctrl+A/Ctrl+E para ir al inicio/final del campo, Ctrl+U para vaciarlo, Ctrl+↑/↓ para cambiar el tamaño de las notas, q o Ctrl+C para salir",
+	"help.add.type":          "Presiona p/h/a/d para Rústica/Tapa dura/Audio/Digital, ←/→ o Tab para cambiar, Esc para volver al menú, Ctrl+C para salir",
+	"help.add.review":        "Presiona y para guardar, n o Esc para seguir editando",
+	"help.add.restore":       "Presiona y para restaurar el borrador, n para descartarlo",
+	"help.edit":              "Presiona Esc para cancelar, Ctrl+A/Ctrl+E para ir al inicio/final del campo, Ctrl+U para vaciarlo, Ctrl+↑/↓ para cambiar el tamaño de las notas, q o Ctrl+C para salir",
+	"help.edit.type":         "Presiona p/h/a/d para Rústica/Tapa dura/Audio/Digital, ←/→ o Tab para cambiar, Esc para cancelar, Ctrl+C para salir",
+}
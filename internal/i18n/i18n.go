@@ -0,0 +1,52 @@
+// Package i18n translates the text shown by Libros
+// Each string has a key, and a catalog per language maps keys to text.
+// Text missing from a catalog falls back to English, so a partial
+// translation never leaves a screen blank
+package i18n
+
+import "github.com/papadavis47/libros/internal/config"
+
+// Key names a translatable string
+// Keys are grouped by screen, e.g. "menu.add" or "form.title"
+type Key string
+
+// Catalog maps keys to the text for one language
+type Catalog map[Key]string
+
+// DefaultLanguage is the language every other catalog falls back to
+const DefaultLanguage = config.DefaultLanguage
+
+// catalogs holds the catalog of each supported language, by language code
+var catalogs = map[string]Catalog{
+	"en": english,
+	"es": spanish,
+}
+
+// Languages returns the codes of the supported languages
+func Languages() []string {
+	return []string{"en", "es"}
+}
+
+// Lookup returns the text for key in lang's own catalog, without falling back
+// ok is false for an unsupported language or a key that catalog does not translate
+func Lookup(lang string, key Key) (string, bool) {
+	text, ok := catalogs[lang][key]
+	return text, ok
+}
+
+// Translate returns the text for key in lang, falling back to English
+// A key missing from every catalog is returned as is, so the gap is visible
+func Translate(lang string, key Key) string {
+	if text, ok := Lookup(lang, key); ok {
+		return text
+	}
+	if text, ok := Lookup(DefaultLanguage, key); ok {
+		return text
+	}
+	return string(key)
+}
+
+// T returns the text for key in the configured language
+func T(key Key) string {
+	return Translate(config.Cached().Language, key)
+}
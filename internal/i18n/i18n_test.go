@@ -0,0 +1,77 @@
+package i18n
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// verbPattern matches the fmt verbs in a catalog string, such as %d or %s
+var verbPattern = regexp.MustCompile(`%[a-z]`)
+
+// TestCatalogs_MatchEnglish tests that every translation has an English original
+// using the same format verbs, so a translated string formats like the original
+func TestCatalogs_MatchEnglish(t *testing.T) {
+	for lang, catalog := range catalogs {
+		for key, text := range catalog {
+			if text == "" {
+				t.Errorf("%s catalog has empty text for %q", lang, key)
+			}
+			original, ok := english[key]
+			if !ok {
+				// Help lines are translated here but kept in English in the help package
+				if strings.HasPrefix(string(key), "help.") {
+					continue
+				}
+				t.Errorf("%s catalog key %q has no English text", lang, key)
+				continue
+			}
+			if got, want := verbPattern.FindAllString(text, -1), verbPattern.FindAllString(original, -1); !slices.Equal(got, want) {
+				t.Errorf("%s catalog key %q uses verbs %v, want %v", lang, key, got, want)
+			}
+		}
+	}
+}
+
+// TestTranslate tests translated text and the fallbacks to English and to the key itself
+func TestTranslate(t *testing.T) {
+	tests := []struct {
+		name string
+		lang string
+		key  Key
+		want string
+	}{
+		{"english", "en", FormTitle, "Title:"},
+		{"spanish", "es", FormTitle, "Título:"},
+		{"unsupported language falls back", "fr", FormTitle, "Title:"},
+		{"missing key falls back", "es", Key("form.only_in_test"), "form.only_in_test"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Translate(tt.lang, tt.key); got != tt.want {
+				t.Errorf("Translate(%q, %q) = %q, want %q", tt.lang, tt.key, got, tt.want)
+			}
+		})
+	}
+
+	// A key only English has still reads in English from a Spanish interface
+	english["form.only_in_english"] = "English only"
+	defer delete(english, "form.only_in_english")
+	if got := Translate("es", "form.only_in_english"); got != "English only" {
+		t.Errorf("Translate(es, missing key) = %q, want the English text", got)
+	}
+}
+
+// TestLanguages tests that every listed language has a catalog
+func TestLanguages(t *testing.T) {
+	for _, lang := range Languages() {
+		if _, ok := catalogs[lang]; !ok {
+			t.Errorf("language %q has no catalog", lang)
+		}
+	}
+	if !slices.Contains(Languages(), DefaultLanguage) {
+		t.Errorf("Languages() = %v, want it to include %q", Languages(), DefaultLanguage)
+	}
+}
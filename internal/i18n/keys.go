@@ -0,0 +1,54 @@
+package i18n
+
+// Shared across screens
+const (
+	AppTitle Key = "app.title" // Title at the top of the main screens
+)
+
+// Main menu
+const (
+	MenuAdd               Key = "menu.add"
+	MenuView              Key = "menu.view"
+	MenuUtilities         Key = "menu.utilities"
+	MenuTheme             Key = "menu.theme"
+	MenuQuit              Key = "menu.quit"
+	MenuLibrary           Key = "menu.library"  // %s is the library file name
+	MenuReading           Key = "menu.reading"  // %s is the title being read
+	MenuWishlist          Key = "menu.wishlist" // %d is the number of wishlist books
+	MenuBooks             Key = "menu.books"    // %d is the number of books, more than one
+	MenuOneBook           Key = "menu.one_book"
+	MenuOneReading        Key = "menu.one_reading"
+	MenuConfigUnreadable  Key = "menu.config_unreadable"   // %v is the parse error
+	MenuConfigResetFailed Key = "menu.config_reset_failed" // %v is the reset error
+	MenuConfigReset       Key = "menu.config_reset"
+)
+
+// Add and edit forms
+const (
+	FormAddTitle         Key = "form.add_title"
+	FormEditTitle        Key = "form.edit_title"
+	FormTitle            Key = "form.title"
+	FormAuthor           Key = "form.author"
+	FormLocation         Key = "form.location"
+	FormType             Key = "form.type"
+	FormNotes            Key = "form.notes"
+	FormOptional         Key = "form.optional"
+	FormNotesPlaceholder Key = "form.notes_placeholder"
+	FormSave             Key = "form.save"
+	FormUpdate           Key = "form.update"
+	FormError            Key = "form.error" // %v is the error
+	FormSaved            Key = "form.saved"
+	FormSession          Key = "form.session" // %d is the number of books added
+	FormReview           Key = "form.review"
+	FormRestore          Key = "form.restore"
+	FormDuplicate        Key = "form.duplicate"
+	FormDuplicateConfirm Key = "form.duplicate_confirm"
+)
+
+// Book types, as "type." followed by the stored type
+const (
+	TypePaperback Key = "type.paperback"
+	TypeHardback  Key = "type.hardback"
+	TypeAudio     Key = "type.audio"
+	TypeDigital   Key = "type.digital"
+)
@@ -21,6 +21,22 @@ type BookRepository interface {
 // Separated from repository to follow single responsibility principle
 type BackupService interface {
 	ExportToJSON(books []models.Book, filePath string) error
+	ExportToJSONL(books []models.Book, filePath string) error
+	ExportToJSONFields(books []models.Book, fields []string, filePath string) error
+	ExportToJSONLFields(books []models.Book, fields []string, filePath string) error
+	ExportEncryptedJSON(books []models.Book, filePath, passphrase string) error
+	ImportEncryptedJSON(filePath, passphrase string) ([]models.Book, error)
+	VerifyJSONExport(filePath string) (models.ExportVerification, error)
 	ExportToMarkdown(books []models.Book, filePath string) error
+	ExportToMarkdownWithTOC(books []models.Book, filePath string) error
+	ExportToText(books []models.Book, filePath string) error
+	ExportTimeline(books []models.Book, filePath string) error
+	ExportNotes(books []models.Book, filePath string) error
+	ExportPerBook(books []models.Book, dir string) error
+	ExportToCalibreCSV(books []models.Book, filePath string) error
+	ExportToGoodreadsCSV(books []models.Book, filePath string) error
+	ExportStats(stats models.Stats, filePath string) error
 	BackupDatabase(sourcePath, destPath string) error
+	WriteChecksum(filePath string) (string, error)
+	AppendManifest(manifestPath string, entry models.ManifestEntry) error
 }
\ No newline at end of file
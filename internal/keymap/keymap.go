@@ -0,0 +1,61 @@
+// Package keymap maps user actions to the keys that trigger them
+// Defaults match the application's built-in bindings, and individual actions
+// can be remapped from the [keybindings] section of the configuration file
+package keymap
+
+import "github.com/papadavis47/libros/internal/config"
+
+// Action names a user action that can be bound to one or more keys
+// The string value is the name used in the [keybindings] config section
+type Action string
+
+const (
+	Up     Action = "up"     // Move the selection up
+	Down   Action = "down"   // Move the selection down
+	Select Action = "select" // Activate the selected item
+	Back   Action = "back"   // Return to the previous screen
+)
+
+// KeyMap holds the keys bound to each action
+// Keys use the names reported by tea.KeyMsg.String(), such as "up", "k" or "enter"
+type KeyMap map[Action][]string
+
+// Default returns the built-in key bindings, supporting both arrow and vim keys
+func Default() KeyMap {
+	return KeyMap{
+		Up:     {"up", "k"},
+		Down:   {"down", "j"},
+		Select: {"enter"},
+		Back:   {"esc"},
+	}
+}
+
+// New returns the default bindings with the given overrides applied
+// Unknown action names and empty key lists are ignored so a partial or
+// mistyped config never leaves an action without any keys
+func New(overrides map[string][]string) KeyMap {
+	keys := Default()
+	for name, bound := range overrides {
+		action := Action(name)
+		if _, ok := keys[action]; !ok || len(bound) == 0 {
+			continue
+		}
+		keys[action] = bound
+	}
+	return keys
+}
+
+// Load returns the key bindings from the current configuration
+func Load() KeyMap {
+	return New(config.GetKeybindings())
+}
+
+// Matches reports whether key is bound to action
+func (k KeyMap) Matches(action Action, key string) bool {
+	for _, bound := range k[action] {
+		if bound == key {
+			return true
+		}
+	}
+	return false
+}
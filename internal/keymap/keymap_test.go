@@ -0,0 +1,83 @@
+package keymap
+
+import "testing"
+
+// TestDefault_MatchesBuiltInKeys tests that the default bindings behave like the original hardcoded keys
+// An empty config must leave navigation exactly as it was
+func TestDefault_MatchesBuiltInKeys(t *testing.T) {
+	keys := Default()
+
+	tests := []struct {
+		name     string
+		action   Action
+		key      string
+		expected bool
+	}{
+		{"arrow up", Up, "up", true},
+		{"vim up", Up, "k", true},
+		{"arrow down", Down, "down", true},
+		{"vim down", Down, "j", true},
+		{"enter selects", Select, "enter", true},
+		{"esc goes back", Back, "esc", true},
+		{"up is not down", Down, "up", false},
+		{"unbound key", Select, "space", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := keys.Matches(tt.action, tt.key); result != tt.expected {
+				t.Errorf("Matches(%q, %q) = %v, want %v", tt.action, tt.key, result, tt.expected)
+			}
+		})
+	}
+}
+
+// TestNew_AppliesOverrides tests merging configured bindings over the defaults
+func TestNew_AppliesOverrides(t *testing.T) {
+	keys := New(map[string][]string{
+		"up":     {"ctrl+p"},
+		"select": {},    // Empty lists keep the default
+		"launch": {"x"}, // Unknown actions are ignored
+	})
+
+	tests := []struct {
+		name     string
+		action   Action
+		key      string
+		expected bool
+	}{
+		{"override added", Up, "ctrl+p", true},
+		{"override replaces defaults", Up, "k", false},
+		{"untouched action keeps default", Down, "j", true},
+		{"empty override keeps default", Select, "enter", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := keys.Matches(tt.action, tt.key); result != tt.expected {
+				t.Errorf("Matches(%q, %q) = %v, want %v", tt.action, tt.key, result, tt.expected)
+			}
+		})
+	}
+
+	if _, ok := keys[Action("launch")]; ok {
+		t.Error("New() should ignore unknown actions")
+	}
+}
+
+// TestNew_NilOverrides tests that a missing keybindings section yields the defaults
+func TestNew_NilOverrides(t *testing.T) {
+	keys := New(nil)
+	defaults := Default()
+
+	if len(keys) != len(defaults) {
+		t.Fatalf("New(nil) has %d actions, want %d", len(keys), len(defaults))
+	}
+	for action, bound := range defaults {
+		for _, key := range bound {
+			if !keys.Matches(action, key) {
+				t.Errorf("New(nil) should bind %q to %q", key, action)
+			}
+		}
+	}
+}
@@ -3,7 +3,14 @@
 // the results of database operations and other asynchronous actions
 package messages
 
-import "github.com/papadavis47/libros/internal/models"
+import (
+	"time"
+
+	"github.com/papadavis47/libros/internal/config"
+	"github.com/papadavis47/libros/internal/database"
+	"github.com/papadavis47/libros/internal/models"
+	"github.com/papadavis47/libros/internal/validation"
+)
 
 // SaveMsg represents the result of a book save operation
 // Contains an error field to indicate success (nil) or failure (error details)
@@ -17,21 +24,252 @@ type UpdateMsg struct {
 	Err error // Error from the update operation, nil if successful
 }
 
+// PinMsg represents the result of pinning or unpinning a book
+// Pinned holds the new state so the UI can update without reloading
+type PinMsg struct {
+	Pinned bool  // Whether the book is now pinned
+	Err    error // Error from the pin operation, nil if successful
+}
+
+// ReadingMsg represents the result of marking or unmarking a book as currently reading
+type ReadingMsg struct {
+	Reading bool  // Whether the book is now the one currently being read
+	Err     error // Error from the update, nil if successful
+}
+
+// OwnedMsg represents the result of marking a book as owned or moving it to the wishlist
+type OwnedMsg struct {
+	Owned bool  // Whether the book is now owned
+	Err   error // Error from the update, nil if successful
+}
+
+// LendMsg represents the result of lending a book or recording its return
+// A returned book has an empty LentTo and a nil LentDate
+type LendMsg struct {
+	LentTo   string     // Who the book is now lent to, empty once returned
+	LentDate *time.Time // When the book was lent, nil once returned
+	Err      error      // Error from the update, nil if successful
+}
+
+// DismissMsg asks a screen to hide a success message once the configured timeout has passed
+// Seq identifies the message, so a timer started for an earlier message is ignored
+type DismissMsg struct {
+	Screen models.Screen // Screen that showed the message
+	Seq    int           // Which of the screen's messages to hide
+}
+
+// DraftMsg carries the unsaved add form draft found when the form opens
+type DraftMsg struct {
+	Draft config.Draft // Fields of the draft, empty when there is nothing to restore
+	Err   error        // Error reading the draft file, nil if successful
+}
+
+// DraftSaveMsg asks the add form to save its draft once typing has paused
+// Seq identifies the change, so a timer started for an earlier keystroke is ignored
+type DraftSaveMsg struct {
+	Seq int // Which of the form's changes to save
+}
+
+// DuplicatesMsg carries the groups of duplicate books found in the collection
+// Each group holds two or more books with the same title and author, oldest first
+type DuplicatesMsg struct {
+	Groups [][]models.Book // Groups of duplicate books
+	Err    error           // Error loading the books, nil if successful
+}
+
+// AttentionMsg carries the Needs Attention report, one group per configured check
+type AttentionMsg struct {
+	Groups []models.AttentionGroup // Books missing each kind of information
+	Err    error                   // Error loading the books, nil if successful
+}
+
+// MergeBooksMsg represents the result of merging a group of duplicate books
+type MergeBooksMsg struct {
+	Title  string // Title of the book the group was merged into
+	Merged int    // Number of records merged away
+	Err    error  // Error from the merge, nil if successful
+}
+
+// CitationMsg represents the result of copying a book's citation to the clipboard
+type CitationMsg struct {
+	Citation string // Citation text that was copied
+	Err      error  // Error writing to the clipboard, nil if successful
+}
+
+// AuthorCountMsg carries how many books share the displayed book's author
+type AuthorCountMsg struct {
+	BookID int   // Book the count was made for
+	Count  int   // Books by the author, including this one
+	Err    error // Error counting the books, nil if successful
+}
+
 // DeleteMsg represents the result of a book delete operation
 // Contains an error field to indicate success (nil) or failure (error details)
 type DeleteMsg struct {
 	Err error // Error from the delete operation, nil if successful
 }
 
+// TypeChangeMsg represents the result of changing the type of several books at once
+type TypeChangeMsg struct {
+	IDs  []int           // IDs of the books that were changed
+	Type models.BookType // Type the books were changed to
+	Err  error           // Error from the update, nil if successful
+}
+
+// BookQuery loads a subset of the collection for the book list, such as DB.LoadWishlist
+type BookQuery func(db *database.DB) ([]models.Book, error)
+
 // LoadBooksMsg represents the result of loading books from the database
 // Contains both the loaded books data and any error that occurred
+// Heading and EmptyText describe a subset of the collection, such as recent additions,
+// and Load is the query that found it, so the list can reload the same subset
+// A fresh load starts at the top of the list; a Reload keeps the user's place
 type LoadBooksMsg struct {
-	Books []models.Book // Slice of books loaded from database
-	Err   error         // Error from the load operation, nil if successful
+	Books     []models.Book // Slice of books loaded from database
+	Heading   string        // List subtitle, empty for the whole collection
+	EmptyText string        // Shown when Books is empty, empty for the default message
+	Load      BookQuery     // Query that loaded Books, nil for the whole collection
+	Reload    bool          // Refresh the list in place, keeping the selection, rather than start at the top
+	Err       error         // Error from the load operation, nil if successful
+}
+
+// ReloadBooksMsg asks the book list to load its books again after they were changed
+// elsewhere, with the same query, heading and empty text as the last load
+type ReloadBooksMsg struct{}
+
+// AuthorsMsg represents the result of loading existing author names for autocomplete
+// Contains the distinct authors in the collection and any error that occurred
+type AuthorsMsg struct {
+	Authors []string // Distinct author names, sorted alphabetically
+	Err     error    // Error from the load operation, nil if successful
 }
 
 // BackupMsg represents the result of a backup operation
 // Contains an error field to indicate success (nil) or failure (error details)
 type BackupMsg struct {
-	Err error // Error from the backup operation, nil if successful
+	Err      error  // Error from the backup operation, nil if successful
+	Warning  string // Problem that did not stop the operation, such as a failed checksum
+	Files    int    // Number of files written by an export with one file per book
+	Checksum string // Path of the checksum written alongside an export, empty if none
+}
+
+// OpenFolderMsg represents the result of opening an export folder in the file manager
+type OpenFolderMsg struct {
+	Path string // Folder that was opened
+	Err  error  // Error opening the folder, nil if the file manager was started
+}
+
+// IntegrityMsg represents the result of a database integrity check
+// OK is true when no problems were found; Problems lists each reported issue
+type IntegrityMsg struct {
+	OK       bool     // Whether the database passed the integrity check
+	Problems []string // Problems reported by the check, empty when OK
+	Err      error    // Error running the check, nil if it completed
+}
+
+// ValidateCollectionMsg represents the result of validating every stored book
+// Issues lists the books that failed validation, empty when all records are valid
+type ValidateCollectionMsg struct {
+	Checked int                      // Number of books that were validated
+	Issues  []validation.RecordIssue // Books with validation problems
+	Err     error                    // Error loading the books, nil if the check completed
+}
+
+// DBInfoMsg represents the result of gathering database file details
+type DBInfoMsg struct {
+	Info models.DBInfo // Path, size, schema version and row count
+	Err  error         // Error querying the database, nil if successful
+}
+
+// StatsMsg represents the result of gathering collection statistics
+type StatsMsg struct {
+	Stats       models.Stats // Summary of the collection
+	AddedByYear map[int]int  // Books added in each year, only years with additions
+	Err         error        // Error from loading the books, nil if successful
+}
+
+// DuplicateCheckMsg represents the result of checking for an existing book with the same title and author
+// Exists is true when another record matches, so the user can be warned before saving
+type DuplicateCheckMsg struct {
+	Exists bool  // Whether a different book with the same title and author exists
+	Err    error // Error from the duplicate check, nil if successful
+}
+
+// ImportPreviewMsg represents the result of reading and parsing a title list for import
+// Skipped holds the 1-based line numbers that could not be parsed
+type ImportPreviewMsg struct {
+	Books   []models.Book // Books that will be imported
+	Skipped []int         // Line numbers that will be skipped
+	Err     error         // Error reading the file, nil if successful
+}
+
+// VerifyExportMsg represents the result of checking a JSON export against its book count
+type VerifyExportMsg struct {
+	Result    models.ExportVerification // Books the export declares and holds
+	BookCount int                       // Books in the collection now
+	Err       error                     // Error reading the export or the collection, nil if both were read
+}
+
+// ImportMsg represents the result of importing books from a title list or the bulk add form
+type ImportMsg struct {
+	Imported int   // Number of books added to the collection
+	Err      error // Error from the import, nil if successful
+}
+
+// PaletteBooksMsg carries the collection loaded when the quick switcher opens
+type PaletteBooksMsg struct {
+	Books []models.Book // Slice of books loaded from database
+	Err   error         // Error from the load operation, nil if successful
+}
+
+// ResumeMsg carries the collection and the remembered screen when a session is resumed
+// BookID is the book to reopen on the detail screen, or 0 to reopen the list
+type ResumeMsg struct {
+	Books  []models.Book // Slice of books loaded from database
+	BookID int           // ID of the book last viewed, 0 if the list was open
+	Err    error         // Error from the load operation, nil if successful
+}
+
+// IncompleteBooksMsg represents the books found with an empty title or author
+type IncompleteBooksMsg struct {
+	Books []models.Book // Books that need a title or author, oldest first
+	Err   error         // Error from the query, nil if successful
+}
+
+// FixBookMsg represents the result of saving a title and author for an incomplete book
+type FixBookMsg struct {
+	Err error // Error from the update, nil if successful
+}
+
+// LibrariesMsg lists the library database files that can be switched to
+type LibrariesMsg struct {
+	Paths []string // Database files in the libros directory, sorted by name
+	Err   error    // Error reading the directory, nil if successful
+}
+
+// LibraryOpenedMsg represents the result of opening another library's database
+// On success the root model closes the current database and switches to DB
+type LibraryOpenedMsg struct {
+	DB   *database.DB // Newly opened database, nil if opening failed
+	Path string       // Database file that was opened
+	Err  error        // Error opening the database, nil if successful
+}
+
+// HistoryMsg carries the saved earlier versions of a book, newest first
+type HistoryMsg struct {
+	Versions []models.BookVersion // Versions saved by earlier edits
+	Err      error                // Error from the query, nil if successful
+}
+
+// RestoreMsg represents the result of putting an earlier version back on its book
+type RestoreMsg struct {
+	Version models.BookVersion // Version that was restored
+	Err     error              // Error from the restore, nil if successful
+}
+
+// ClearCollectionMsg represents the result of deleting every book in the collection
+// BackupPath is where the database was copied before clearing, set even if the clear fails
+type ClearCollectionMsg struct {
+	BackupPath string // Location of the safety backup taken before clearing
+	Err        error  // Error from the backup or clear operation, nil if successful
 }
@@ -0,0 +1,72 @@
+package models
+
+import "strings"
+
+// Names of the Needs Attention checks, as used in the attention config option
+const (
+	AttentionNotes    = "notes"
+	AttentionLocation = "location"
+	AttentionAuthor   = "author"
+)
+
+// AttentionCheck is one kind of missing information the Needs Attention report looks for
+type AttentionCheck struct {
+	Name    string          // Name used in the attention config option
+	Label   string          // Heading shown on the report
+	Missing func(Book) bool // Reports whether a book lacks this information
+}
+
+// AttentionChecks lists every check in the order the report shows them by default
+// A shelf location is only expected of physical books that are owned
+var AttentionChecks = []AttentionCheck{
+	{
+		Name:  AttentionNotes,
+		Label: "No notes",
+		Missing: func(book Book) bool {
+			return strings.TrimSpace(book.Notes) == ""
+		},
+	},
+	{
+		Name:  AttentionLocation,
+		Label: "No shelf location",
+		Missing: func(book Book) bool {
+			physical := book.Type == Paperback || book.Type == Hardback
+			return physical && book.Owned && strings.TrimSpace(book.Location) == ""
+		},
+	},
+	{
+		Name:  AttentionAuthor,
+		Label: "No author",
+		Missing: func(book Book) bool {
+			return strings.TrimSpace(book.Author) == ""
+		},
+	},
+}
+
+// AttentionGroup holds the books that fail one check
+type AttentionGroup struct {
+	Check AttentionCheck
+	Books []Book
+}
+
+// NeedsAttention runs the named checks over books and returns a group for each, in
+// the order named. Groups are returned even when empty so the report can show a zero
+// count, and unknown names are skipped. Books keep their order within each group.
+func NeedsAttention(books []Book, names []string) []AttentionGroup {
+	groups := make([]AttentionGroup, 0, len(names))
+	for _, name := range names {
+		for _, check := range AttentionChecks {
+			if check.Name != name {
+				continue
+			}
+			group := AttentionGroup{Check: check}
+			for _, book := range books {
+				if check.Missing(book) {
+					group.Books = append(group.Books, book)
+				}
+			}
+			groups = append(groups, group)
+		}
+	}
+	return groups
+}
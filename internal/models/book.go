@@ -2,7 +2,10 @@
 // It contains the Book model, book types, and screen navigation constants
 package models
 
-import "time"
+import (
+	"strings"
+	"time"
+)
 
 // BookType represents the different formats a book can be in
 // This is stored as a string in the database but used as a typed constant
@@ -16,16 +19,37 @@ const (
 	Digital   BookType = "digital"   // Digital/eBook format
 )
 
+// BookTypes lists every book type in the order forms offer them
+var BookTypes = []BookType{Paperback, Hardback, Audio, Digital}
+
+// ParseBookType converts a type name such as "Audio" to a BookType
+// Matching ignores case and surrounding spaces; ok is false for unknown names
+func ParseBookType(name string) (bookType BookType, ok bool) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for _, t := range BookTypes {
+		if string(t) == name {
+			return t, true
+		}
+	}
+	return "", false
+}
+
 // Book represents a book record in the database
 // Contains all the metadata and user data associated with a book entry
 type Book struct {
-	ID        int       // Unique database identifier
-	Title     string    // Book title
-	Author    string    // Book author name
-	Type      BookType  // Format type (paperback, hardback, etc.)
-	Notes     string    // User notes about the book
-	CreatedAt time.Time // When the book record was created
-	UpdatedAt time.Time // When the book record was last modified
+	ID        int        // Unique database identifier
+	Title     string     // Book title
+	Author    string     // Book author name
+	Type      BookType   // Format type (paperback, hardback, etc.)
+	Notes     string     // User notes about the book
+	Location  string     // Where a physical copy is kept, e.g. "Shelf B, top" (optional)
+	Pinned    bool       // Whether the book is kept at the top of the list
+	Reading   bool       // Whether this is the book currently being read (at most one)
+	Owned     bool       // Whether the book is owned; false for books on the wishlist
+	LentTo    string     // Who the book is lent to; empty when it is not lent out
+	LentDate  *time.Time // When the book was lent; nil when it is not lent out
+	CreatedAt time.Time  // When the book record was created
+	UpdatedAt time.Time  // When the book record was last modified
 }
 
 // Screen represents the different UI screens/views in the application
@@ -43,4 +67,17 @@ const (
 	ExportScreen                  // Screen for exporting book data
 	BackupScreen                  // Screen for backing up book data
 	ThemeScreen                   // Screen for theme selection
+	IntegrityScreen               // Screen for checking database integrity
+	ClearScreen                   // Screen for clearing the whole collection
+	ValidateScreen                // Screen for validating every stored book
+	StatsScreen                   // Screen showing collection statistics
+	DuplicatesScreen              // Screen for finding and merging duplicate books
+	DBInfoScreen                  // Screen showing database file details
+	ImportScreen                  // Screen for importing books from a title list
+	NormalizeScreen               // Screen for fixing books with an empty title or author
+	LibraryScreen                 // Screen for switching to another library database
+	HistoryScreen                 // Screen listing and restoring earlier versions of a book
+	BulkAddScreen                 // Screen for adding several books from pasted lines
+	VerifyExportScreen            // Screen for checking a JSON export against its book count
+	AttentionScreen               // Screen reporting books that are missing information
 )
@@ -0,0 +1,11 @@
+package models
+
+// DBInfo describes the database file for the Database Info screen
+type DBInfo struct {
+	Path          string // Path the database was opened from
+	Size          int64  // File size in bytes, valid only when SizeErr is nil
+	SizeErr       error  // Why the file size could not be read, such as a missing file
+	SchemaVersion int    // SQLite user_version, set as each schema migration is applied
+	SQLiteVersion string // Version of the SQLite library in use
+	BookCount     int    // Rows in the books table
+}
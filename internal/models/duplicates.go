@@ -0,0 +1,50 @@
+package models
+
+import (
+	"sort"
+	"strings"
+)
+
+// DuplicateKey normalizes a title and author for duplicate detection
+// Case and runs of whitespace are ignored, so "The  Hobbit" matches "the hobbit"
+func DuplicateKey(title, author string) string {
+	normalize := func(s string) string {
+		return strings.ToLower(strings.Join(strings.Fields(s), " "))
+	}
+	return normalize(title) + "\x00" + normalize(author)
+}
+
+// DuplicateGroups groups books that share a normalized title and author
+// Only groups with more than one book are returned. Each group is ordered oldest
+// first, and the groups are ordered by normalized title, then author.
+func DuplicateGroups(books []Book) [][]Book {
+	byKey := make(map[string][]Book)
+	var keys []string
+	for _, book := range books {
+		key := DuplicateKey(book.Title, book.Author)
+		if _, seen := byKey[key]; !seen {
+			keys = append(keys, key)
+		}
+		byKey[key] = append(byKey[key], book)
+	}
+
+	var groups [][]Book
+	for _, key := range keys {
+		group := byKey[key]
+		if len(group) < 2 {
+			continue
+		}
+		sort.SliceStable(group, func(i, j int) bool {
+			if !group[i].CreatedAt.Equal(group[j].CreatedAt) {
+				return group[i].CreatedAt.Before(group[j].CreatedAt)
+			}
+			return group[i].ID < group[j].ID
+		})
+		groups = append(groups, group)
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool {
+		return DuplicateKey(groups[i][0].Title, groups[i][0].Author) < DuplicateKey(groups[j][0].Title, groups[j][0].Author)
+	})
+	return groups
+}
@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// BookVersion is an earlier version of a book's editable fields
+// A version is saved each time an edit changes the book, so the edit can be undone
+type BookVersion struct {
+	ID        int       // Unique history identifier
+	BookID    int       // Book this version belongs to
+	Title     string    // Title before the edit
+	Author    string    // Author before the edit
+	Type      BookType  // Format type before the edit
+	Notes     string    // Notes before the edit
+	Location  string    // Location before the edit
+	ChangedAt time.Time // When the edit replaced these values
+}
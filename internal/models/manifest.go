@@ -0,0 +1,20 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// ManifestEntry describes one successful export, as recorded in the export manifest
+type ManifestEntry struct {
+	Time   time.Time // When the export finished
+	Format string    // Export format, e.g. "json" or "per-book"
+	Path   string    // File, or folder for one file per book, that was written
+	Books  int       // Number of books exported
+}
+
+// String formats the entry as one tab-separated manifest line, e.g.
+// "2024-05-01T10:04:05-07:00	json	12 books	/home/me/.libros/exports/books.json"
+func (e ManifestEntry) String() string {
+	return fmt.Sprintf("%s\t%s\t%d books\t%s", e.Time.Format(time.RFC3339), e.Format, e.Books, e.Path)
+}
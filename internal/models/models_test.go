@@ -1,6 +1,7 @@
 package models
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -58,6 +59,28 @@ func TestBookType_Values(t *testing.T) {
 	}
 }
 
+// TestParseBookType tests converting type names to BookType values
+func TestParseBookType(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected BookType
+		ok       bool
+	}{
+		{"paperback", Paperback, true},
+		{" Audio ", Audio, true},
+		{"DIGITAL", Digital, true},
+		{"scroll", "", false},
+		{"", "", false},
+	}
+
+	for _, tt := range tests {
+		got, ok := ParseBookType(tt.name)
+		if got != tt.expected || ok != tt.ok {
+			t.Errorf("ParseBookType(%q) = %q, %v, want %q, %v", tt.name, got, ok, tt.expected, tt.ok)
+		}
+	}
+}
+
 // TestBook_Validation tests that Book model fields accept valid data
 // This test ensures the model can handle typical use cases without data corruption
 func TestBook_Validation(t *testing.T) {
@@ -114,6 +137,16 @@ func TestScreenType_Values(t *testing.T) {
 		{"export screen", ExportScreen, 6},
 		{"backup screen", BackupScreen, 7},
 		{"theme screen", ThemeScreen, 8},
+		{"integrity screen", IntegrityScreen, 9},
+		{"clear screen", ClearScreen, 10},
+		{"validate screen", ValidateScreen, 11},
+		{"stats screen", StatsScreen, 12},
+		{"duplicates screen", DuplicatesScreen, 13},
+		{"database info screen", DBInfoScreen, 14},
+		{"import screen", ImportScreen, 15},
+		{"normalize screen", NormalizeScreen, 16},
+		{"library screen", LibraryScreen, 17},
+		{"history screen", HistoryScreen, 18},
 	}
 
 	for _, tt := range tests {
@@ -123,4 +156,132 @@ func TestScreenType_Values(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+
+// TestComputeStats tests note statistics, including books with empty or blank notes
+func TestComputeStats(t *testing.T) {
+	books := []Book{
+		{Title: "A", Type: Paperback, Notes: "one two three"},
+		{Title: "B", Type: Paperback, Notes: ""},
+		{Title: "C", Type: Paperback, Notes: "   \n  "},
+		{Title: "D", Type: Paperback, Notes: "four  five\nsix seven"},
+	}
+
+	stats := ComputeStats(books)
+	if stats.TotalBooks != 4 {
+		t.Errorf("TotalBooks = %d, want 4", stats.TotalBooks)
+	}
+	if stats.BooksWithNotes != 2 {
+		t.Errorf("BooksWithNotes = %d, want 2", stats.BooksWithNotes)
+	}
+	if stats.NoteWords != 7 {
+		t.Errorf("NoteWords = %d, want 7", stats.NoteWords)
+	}
+	// 7 words over 2 books with notes rounds to 4
+	if got := stats.AverageNoteWords(); got != 4 {
+		t.Errorf("AverageNoteWords() = %d, want 4", got)
+	}
+
+	if stats.ByType[Paperback] != 4 {
+		t.Errorf("ByType[paperback] = %d, want 4", stats.ByType[Paperback])
+	}
+
+	if got := ComputeStats(nil).AverageNoteWords(); got != 0 {
+		t.Errorf("AverageNoteWords() with no notes = %d, want 0", got)
+	}
+}
+
+// TestComputeStats_Breakdowns tests the type and status counts and the date range
+func TestComputeStats_Breakdowns(t *testing.T) {
+	first := time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)
+	last := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	books := []Book{
+		{Title: "A", Type: Audio, Owned: true, Reading: true, CreatedAt: last},
+		{Title: "B", Type: Paperback, Owned: true, Pinned: true, CreatedAt: first},
+		{Title: "C", Type: Audio, Owned: false, CreatedAt: time.Date(2023, 9, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	stats := ComputeStats(books)
+	if stats.ByType[Audio] != 2 || stats.ByType[Paperback] != 1 || stats.ByType[Digital] != 0 {
+		t.Errorf("ByType = %v, want audio 2 and paperback 1", stats.ByType)
+	}
+	want := StatusCounts{Owned: 2, Wishlist: 1, Reading: 1, Pinned: 1}
+	if stats.ByStatus != want {
+		t.Errorf("ByStatus = %+v, want %+v", stats.ByStatus, want)
+	}
+	if stats.FirstAdded == nil || !stats.FirstAdded.Equal(first) {
+		t.Errorf("FirstAdded = %v, want %v", stats.FirstAdded, first)
+	}
+	if stats.LastAdded == nil || !stats.LastAdded.Equal(last) {
+		t.Errorf("LastAdded = %v, want %v", stats.LastAdded, last)
+	}
+
+	empty := ComputeStats(nil)
+	if empty.FirstAdded != nil || empty.LastAdded != nil {
+		t.Error("An empty collection should have no date range")
+	}
+}
+
+// TestDuplicateGroups tests that books sharing a normalized title and author are grouped
+// oldest first, and that books without a duplicate are left out
+func TestDuplicateGroups(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	books := []Book{
+		{ID: 1, Title: "Dune", Author: "Frank Herbert", CreatedAt: base.Add(2 * time.Hour)},
+		{ID: 2, Title: "Emma", Author: "Jane Austen", CreatedAt: base},
+		{ID: 3, Title: "  dune ", Author: "FRANK  HERBERT", CreatedAt: base},
+		{ID: 4, Title: "Dune", Author: "Brian Herbert", CreatedAt: base},
+		{ID: 5, Title: "Beloved", Author: "Toni Morrison", CreatedAt: base},
+		{ID: 6, Title: "Beloved", Author: "toni morrison", CreatedAt: base},
+	}
+
+	groups := DuplicateGroups(books)
+	var got [][]int
+	for _, group := range groups {
+		var ids []int
+		for _, book := range group {
+			ids = append(ids, book.ID)
+		}
+		got = append(got, ids)
+	}
+
+	want := [][]int{{5, 6}, {3, 1}}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("DuplicateGroups() ids = %v, want %v", got, want)
+	}
+}
+
+// TestNeedsAttention tests that each named check lists the books missing that information,
+// in the order named, and that a shelf location is only expected of owned physical books
+func TestNeedsAttention(t *testing.T) {
+	books := []Book{
+		{ID: 1, Title: "Dune", Author: "Frank Herbert", Type: Paperback, Owned: true, Notes: "Spice", Location: "Shelf A"},
+		{ID: 2, Title: "Emma", Author: "Jane Austen", Type: Hardback, Owned: true, Notes: "  "},
+		{ID: 3, Title: "Beloved", Author: "", Type: Audio, Owned: true},
+		{ID: 4, Title: "Ulysses", Author: "James Joyce", Type: Paperback, Owned: false, Notes: "Someday"},
+	}
+
+	groups := NeedsAttention(books, []string{AttentionAuthor, "rating", AttentionNotes, AttentionLocation})
+	got := make(map[string][]int)
+	var order []string
+	for _, group := range groups {
+		order = append(order, group.Check.Name)
+		var ids []int
+		for _, book := range group.Books {
+			ids = append(ids, book.ID)
+		}
+		got[group.Check.Name] = ids
+	}
+
+	if want := []string{AttentionAuthor, AttentionNotes, AttentionLocation}; fmt.Sprint(order) != fmt.Sprint(want) {
+		t.Errorf("NeedsAttention() checks = %v, want %v", order, want)
+	}
+	want := map[string][]int{
+		AttentionAuthor:   {3},
+		AttentionNotes:    {2, 3},
+		AttentionLocation: {2},
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("NeedsAttention() ids = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,71 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// Stats summarizes the collection for the statistics screen and the statistics export
+// JSON field order is fixed and by_type keys are written sorted, so exports diff cleanly
+type Stats struct {
+	TotalBooks     int              `json:"total_books"`           // Number of books in the collection
+	BooksWithNotes int              `json:"books_with_notes"`      // Books whose notes contain at least one word
+	NoteWords      int              `json:"note_words"`            // Words across all notes
+	ByType         map[BookType]int `json:"by_type"`               // Number of books of each type
+	ByStatus       StatusCounts     `json:"by_status"`             // Number of books with each status flag
+	FirstAdded     *time.Time       `json:"first_added,omitempty"` // When the oldest book was added, nil for an empty collection
+	LastAdded      *time.Time       `json:"last_added,omitempty"`  // When the newest book was added, nil for an empty collection
+}
+
+// StatusCounts counts books by their status flags
+// Owned and Wishlist always add up to the total; the others overlap with them
+type StatusCounts struct {
+	Owned    int `json:"owned"`    // Books in the collection
+	Wishlist int `json:"wishlist"` // Books not yet owned
+	Reading  int `json:"reading"`  // The currently reading book, 0 or 1
+	Pinned   int `json:"pinned"`   // Books pinned to the top of the list
+}
+
+// ComputeStats builds collection statistics from a list of books
+// Words are counted as runs of non-space characters, so blank notes count as none
+func ComputeStats(books []Book) Stats {
+	stats := Stats{TotalBooks: len(books), ByType: map[BookType]int{}}
+	for _, book := range books {
+		words := len(strings.Fields(book.Notes))
+		if words > 0 {
+			stats.BooksWithNotes++
+			stats.NoteWords += words
+		}
+
+		stats.ByType[book.Type]++
+		if book.Owned {
+			stats.ByStatus.Owned++
+		} else {
+			stats.ByStatus.Wishlist++
+		}
+		if book.Reading {
+			stats.ByStatus.Reading++
+		}
+		if book.Pinned {
+			stats.ByStatus.Pinned++
+		}
+
+		created := book.CreatedAt
+		if stats.FirstAdded == nil || created.Before(*stats.FirstAdded) {
+			stats.FirstAdded = &created
+		}
+		if stats.LastAdded == nil || created.After(*stats.LastAdded) {
+			stats.LastAdded = &created
+		}
+	}
+	return stats
+}
+
+// AverageNoteWords returns the mean note length in words, rounded to the nearest word
+// Only books with notes are counted, so empty notes do not drag the average down
+func (s Stats) AverageNoteWords() int {
+	if s.BooksWithNotes == 0 {
+		return 0
+	}
+	return (s.NoteWords + s.BooksWithNotes/2) / s.BooksWithNotes
+}
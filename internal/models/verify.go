@@ -0,0 +1,12 @@
+package models
+
+// ExportVerification compares the book count a JSON export declares with the books it holds
+type ExportVerification struct {
+	TotalBooks int // Count written in the export's total_books field
+	BooksFound int // Books actually present in the export's books array
+}
+
+// Complete reports whether the export holds as many books as it declares
+func (v ExportVerification) Complete() bool {
+	return v.TotalBooks == v.BooksFound
+}
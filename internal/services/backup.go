@@ -1,11 +1,18 @@
 package services
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
 	"time"
+	"unicode"
 
 	"github.com/papadavis47/libros/internal/constants"
 	"github.com/papadavis47/libros/internal/interfaces"
@@ -56,24 +63,254 @@ func (s *BackupService) ExportToJSON(books []models.Book, filePath string) error
 	return nil
 }
 
+// StatsData represents the structure of the exported statistics JSON
+// The statistics fields are written at the top level, after the export date
+type StatsData struct {
+	ExportDate       time.Time `json:"export_date"`
+	AverageNoteWords int       `json:"average_note_words"`
+	models.Stats
+}
+
+// ExportStats exports collection statistics to a JSON file
+func (s *BackupService) ExportStats(stats models.Stats, filePath string) error {
+	statsData := StatsData{
+		ExportDate:       time.Now(),
+		AverageNoteWords: stats.AverageNoteWords(),
+		Stats:            stats,
+	}
+
+	// Marshal to JSON with proper formatting
+	jsonData, err := json.MarshalIndent(statsData, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal statistics to JSON: %v", err)
+	}
+
+	// Ensure directory exists
+	if err := os.MkdirAll(filepath.Dir(filePath), constants.DirPermissions); err != nil {
+		return fmt.Errorf("failed to create directory: %v", err)
+	}
+
+	// Write to file
+	if err := os.WriteFile(filePath, jsonData, constants.FilePermissions); err != nil {
+		return fmt.Errorf("failed to write statistics file: %v", err)
+	}
+
+	return nil
+}
+
+// ExportToJSONL exports books as newline-delimited JSON, one book object per line
+// There is no wrapper object, so an empty collection produces an empty file
+func (s *BackupService) ExportToJSONL(books []models.Book, filePath string) error {
+	var buf bytes.Buffer
+	for _, book := range books {
+		line, err := json.Marshal(book)
+		if err != nil {
+			return fmt.Errorf("failed to marshal book to JSON: %v", err)
+		}
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+
+	// Ensure directory exists
+	if err := os.MkdirAll(filepath.Dir(filePath), constants.DirPermissions); err != nil {
+		return fmt.Errorf("failed to create directory: %v", err)
+	}
+
+	// Write to file
+	if err := os.WriteFile(filePath, buf.Bytes(), constants.FilePermissions); err != nil {
+		return fmt.Errorf("failed to write JSONL file: %v", err)
+	}
+
+	return nil
+}
+
+// Optional fields of a JSON or JSON Lines export
+// The ID and title are always written, so every exported book can be identified
+const (
+	FieldAuthor   = "author"
+	FieldType     = "type"
+	FieldNotes    = "notes"
+	FieldLocation = "location"
+	FieldStatus   = "status" // Pinned, Reading and Owned flags, and who the book is lent to
+	FieldDates    = "dates"  // CreatedAt and UpdatedAt timestamps
+)
+
+// ExportFields lists every optional export field in the order books are written with them
+var ExportFields = []string{FieldAuthor, FieldType, FieldNotes, FieldLocation, FieldStatus, FieldDates}
+
+// FieldsBackupData is the JSON export structure when only some fields are included
+// Each book holds its ID, title and the chosen fields, in the same order ExportToJSON writes them
+type FieldsBackupData struct {
+	ExportDate time.Time         `json:"export_date"`
+	TotalBooks int               `json:"total_books"`
+	Fields     []string          `json:"fields"`
+	Books      []json.RawMessage `json:"books"`
+}
+
+// ExportToJSONFields exports books to a JSON file with only the chosen optional fields
+// Unknown field names are ignored; excluded fields do not appear in the book objects at all
+func (s *BackupService) ExportToJSONFields(books []models.Book, fields []string, filePath string) error {
+	fields = knownFields(fields)
+	backupData := FieldsBackupData{
+		ExportDate: time.Now(),
+		TotalBooks: len(books),
+		Fields:     fields,
+		Books:      make([]json.RawMessage, 0, len(books)),
+	}
+	for _, book := range books {
+		object, err := bookJSON(book, fields)
+		if err != nil {
+			return fmt.Errorf("failed to marshal book to JSON: %v", err)
+		}
+		backupData.Books = append(backupData.Books, object)
+	}
+
+	// Marshal to JSON with proper formatting
+	jsonData, err := json.MarshalIndent(backupData, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal data to JSON: %v", err)
+	}
+
+	// Ensure directory exists
+	if err := os.MkdirAll(filepath.Dir(filePath), constants.DirPermissions); err != nil {
+		return fmt.Errorf("failed to create directory: %v", err)
+	}
+
+	// Write to file
+	if err := os.WriteFile(filePath, jsonData, constants.FilePermissions); err != nil {
+		return fmt.Errorf("failed to write JSON file: %v", err)
+	}
+
+	return nil
+}
+
+// ExportToJSONLFields exports books as newline-delimited JSON with only the chosen optional fields
+// Unknown field names are ignored; excluded fields do not appear in the book objects at all
+func (s *BackupService) ExportToJSONLFields(books []models.Book, fields []string, filePath string) error {
+	fields = knownFields(fields)
+	var buf bytes.Buffer
+	for _, book := range books {
+		line, err := bookJSON(book, fields)
+		if err != nil {
+			return fmt.Errorf("failed to marshal book to JSON: %v", err)
+		}
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+
+	// Ensure directory exists
+	if err := os.MkdirAll(filepath.Dir(filePath), constants.DirPermissions); err != nil {
+		return fmt.Errorf("failed to create directory: %v", err)
+	}
+
+	// Write to file
+	if err := os.WriteFile(filePath, buf.Bytes(), constants.FilePermissions); err != nil {
+		return fmt.Errorf("failed to write JSONL file: %v", err)
+	}
+
+	return nil
+}
+
+// knownFields returns the fields that are export fields, in ExportFields order
+func knownFields(fields []string) []string {
+	chosen := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		chosen[field] = true
+	}
+	known := make([]string, 0, len(ExportFields))
+	for _, field := range ExportFields {
+		if chosen[field] {
+			known = append(known, field)
+		}
+	}
+	return known
+}
+
+// bookJSON encodes a book as a JSON object with its ID, title and the given fields
+// Keys match the full export, so a file with every field reads like one from ExportToJSON
+func bookJSON(book models.Book, fields []string) (json.RawMessage, error) {
+	type member struct {
+		key   string
+		value interface{}
+	}
+	members := []member{{"ID", book.ID}, {"Title", book.Title}}
+	for _, field := range fields {
+		switch field {
+		case FieldAuthor:
+			members = append(members, member{"Author", book.Author})
+		case FieldType:
+			members = append(members, member{"Type", book.Type})
+		case FieldNotes:
+			members = append(members, member{"Notes", book.Notes})
+		case FieldLocation:
+			members = append(members, member{"Location", book.Location})
+		case FieldStatus:
+			members = append(members, member{"Pinned", book.Pinned}, member{"Reading", book.Reading}, member{"Owned", book.Owned}, member{"LentTo", book.LentTo}, member{"LentDate", book.LentDate})
+		case FieldDates:
+			members = append(members, member{"CreatedAt", book.CreatedAt}, member{"UpdatedAt", book.UpdatedAt})
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, m := range members {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(m.key)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(m.value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
 // ExportToMarkdown exports books to a Markdown file
 func (s *BackupService) ExportToMarkdown(books []models.Book, filePath string) error {
+	return writeMarkdown(books, filePath, false)
+}
+
+// ExportToMarkdownWithTOC exports books to a Markdown file that opens with a table of contents
+// Each entry links to the book's heading using the anchor GitHub-style renderers generate for it
+func (s *BackupService) ExportToMarkdownWithTOC(books []models.Book, filePath string) error {
+	return writeMarkdown(books, filePath, true)
+}
+
+// writeMarkdown builds the Markdown export, with or without a table of contents
+// Numbered headings are kept for the plain export; with a table of contents each
+// heading is just the title so its anchor matches the slug in the contents list
+func writeMarkdown(books []models.Book, filePath string, withTOC bool) error {
 	// Create markdown content
 	md := fmt.Sprintf("# Book Collection Export\n\n")
 	md += fmt.Sprintf("**Export Date:** %s  \n", time.Now().Format("January 2, 2006"))
 	md += fmt.Sprintf("**Total Books:** %d  \n\n", len(books))
 
+	// Add the table of contents
+	if withTOC && len(books) > 0 {
+		md += "## Contents\n\n"
+		seen := map[string]int{"contents": 1} // The contents heading has its own anchor
+		for _, book := range books {
+			md += fmt.Sprintf("- [%s](#%s)\n", book.Title, markdownSlug(book.Title, seen))
+		}
+		md += "\n---\n\n"
+	}
+
 	// Add each book
 	for i, book := range books {
-		md += fmt.Sprintf("## %d. %s\n\n", i+1, book.Title)
-		md += fmt.Sprintf("**Author:** %s  \n", book.Author)
-		md += fmt.Sprintf("**Type:** %s  \n", utils.FormatBookType(book.Type))
-		md += fmt.Sprintf("**Created:** %s  \n", utils.FormatDate(book.CreatedAt))
-		md += fmt.Sprintf("**Updated:** %s  \n", utils.FormatDate(book.UpdatedAt))
-
-		if book.Notes != "" {
-			md += fmt.Sprintf("\n**Notes:**  \n%s\n", book.Notes)
+		if withTOC {
+			md += fmt.Sprintf("## %s\n\n", book.Title)
+		} else {
+			md += fmt.Sprintf("## %d. %s\n\n", i+1, book.Title)
 		}
+		md += markdownBookDetails(book)
 		md += "\n---\n\n"
 	}
 
@@ -90,6 +327,350 @@ func (s *BackupService) ExportToMarkdown(books []models.Book, filePath string) e
 	return nil
 }
 
+// markdownBookDetails renders a book's fields and notes as the lines that
+// follow its heading in the Markdown exports
+func markdownBookDetails(book models.Book) string {
+	md := fmt.Sprintf("**Author:** %s  \n", book.Author)
+	md += fmt.Sprintf("**Type:** %s  \n", utils.FormatBookType(book.Type))
+	if book.Location != "" {
+		md += fmt.Sprintf("**Location:** %s  \n", book.Location)
+	}
+	if !book.Owned {
+		md += "**Wishlist:** Not yet owned  \n"
+	}
+	md += fmt.Sprintf("**Created:** %s  \n", utils.FormatDate(book.CreatedAt))
+	md += fmt.Sprintf("**Updated:** %s  \n", utils.FormatDate(book.UpdatedAt))
+
+	if book.Notes != "" {
+		md += fmt.Sprintf("\n**Notes:**  \n%s\n", book.Notes)
+	}
+	return md
+}
+
+// ExportPerBook exports each book to its own Markdown file in dir, for wikis with a page per book
+// Files are named by a slug of the title, e.g. the-go-programming-language.md; when two titles
+// share a slug, the later book's file also gets its ID, e.g. dune-7.md
+func (s *BackupService) ExportPerBook(books []models.Book, dir string) error {
+	// Ensure directory exists
+	if err := os.MkdirAll(dir, constants.DirPermissions); err != nil {
+		return fmt.Errorf("failed to create directory: %v", err)
+	}
+
+	used := make(map[string]bool, len(books))
+	for _, book := range books {
+		name := fileSlug(book.Title)
+		if used[name] {
+			name = fmt.Sprintf("%s-%d", name, book.ID)
+		}
+		used[name] = true
+
+		md := fmt.Sprintf("# %s\n\n", book.Title) + markdownBookDetails(book)
+		if err := os.WriteFile(filepath.Join(dir, name+".md"), []byte(md), constants.FilePermissions); err != nil {
+			return fmt.Errorf("failed to write markdown file for %q: %v", book.Title, err)
+		}
+	}
+
+	return nil
+}
+
+// fileSlug converts a title into a file name: lowercased letters and digits
+// joined by single hyphens, or "book" when the title has neither
+func fileSlug(title string) string {
+	var b strings.Builder
+	hyphen := false
+	for _, r := range strings.ToLower(title) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if hyphen && b.Len() > 0 {
+				b.WriteRune('-')
+			}
+			b.WriteRune(r)
+			hyphen = false
+		} else {
+			hyphen = true
+		}
+	}
+	if b.Len() == 0 {
+		return "book"
+	}
+	return b.String()
+}
+
+// markdownSlug converts a heading into the anchor GitHub-style renderers give it:
+// lowercased, punctuation removed and spaces replaced with hyphens
+// Repeated slugs get -1, -2, ... suffixes in order, tracked in seen
+func markdownSlug(heading string, seen map[string]int) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(strings.TrimSpace(heading)) {
+		switch {
+		case unicode.IsLetter(r), unicode.IsDigit(r), r == '-', r == '_':
+			b.WriteRune(r)
+		case r == ' ':
+			b.WriteRune('-')
+		}
+	}
+	slug := b.String()
+
+	count := seen[slug]
+	seen[slug] = count + 1
+	if count > 0 {
+		return fmt.Sprintf("%s-%d", slug, count)
+	}
+	return slug
+}
+
+// ExportToText exports books to a plain-text file suitable for reading or printing
+// Notes are wrapped to the standard text width and each book is separated by a rule
+func (s *BackupService) ExportToText(books []models.Book, filePath string) error {
+	var txt strings.Builder
+	rule := strings.Repeat("-", constants.TextWrapWidth)
+
+	// Header with export metadata
+	txt.WriteString("BOOK COLLECTION EXPORT\n")
+	txt.WriteString(strings.Repeat("=", constants.TextWrapWidth) + "\n")
+	txt.WriteString(fmt.Sprintf("Export Date: %s\n", time.Now().Format("January 2, 2006")))
+	txt.WriteString(fmt.Sprintf("Total Books: %d\n\n", len(books)))
+
+	// Add each book
+	for i, book := range books {
+		txt.WriteString(rule + "\n")
+		txt.WriteString(fmt.Sprintf("%d. %s\n", i+1, book.Title))
+		txt.WriteString(rule + "\n")
+		txt.WriteString(fmt.Sprintf("Author:  %s\n", book.Author))
+		txt.WriteString(fmt.Sprintf("Type:    %s\n", utils.FormatBookType(book.Type)))
+		if book.Location != "" {
+			txt.WriteString(fmt.Sprintf("Location: %s\n", book.Location))
+		}
+		if !book.Owned {
+			txt.WriteString("Wishlist: Not yet owned\n")
+		}
+		txt.WriteString(fmt.Sprintf("Added:   %s\n", utils.FormatDate(book.CreatedAt)))
+		txt.WriteString(fmt.Sprintf("Updated: %s\n", utils.FormatDate(book.UpdatedAt)))
+
+		if book.Notes != "" {
+			txt.WriteString("\nNotes:\n")
+			txt.WriteString(utils.WrapText(book.Notes, constants.TextWrapWidth) + "\n")
+		}
+		txt.WriteString("\n")
+	}
+
+	// Ensure directory exists
+	if err := os.MkdirAll(filepath.Dir(filePath), constants.DirPermissions); err != nil {
+		return fmt.Errorf("failed to create directory: %v", err)
+	}
+
+	// Write to file
+	if err := os.WriteFile(filePath, []byte(txt.String()), constants.FilePermissions); err != nil {
+		return fmt.Errorf("failed to write text file: %v", err)
+	}
+
+	return nil
+}
+
+// ExportTimeline exports books as a chronological Markdown reading log
+// Books are ordered by the date they were added and grouped under a heading for each month
+func (s *BackupService) ExportTimeline(books []models.Book, filePath string) error {
+	// Sort a copy oldest first so the caller's slice order is left untouched
+	sorted := make([]models.Book, len(books))
+	copy(sorted, books)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].CreatedAt.Before(sorted[j].CreatedAt)
+	})
+
+	var md strings.Builder
+	md.WriteString("# Reading Timeline\n\n")
+	md.WriteString(fmt.Sprintf("**Export Date:** %s  \n", time.Now().Format("January 2, 2006")))
+	md.WriteString(fmt.Sprintf("**Total Books:** %d  \n", len(sorted)))
+
+	// Start a new month heading whenever the month changes
+	currentMonth := ""
+	for _, book := range sorted {
+		month := book.CreatedAt.Format("January 2006")
+		if month != currentMonth {
+			md.WriteString(fmt.Sprintf("\n## %s\n\n", month))
+			currentMonth = month
+		}
+		md.WriteString(fmt.Sprintf("- **%s** — Added *%s* by %s (%s)\n",
+			utils.FormatDate(book.CreatedAt), book.Title, book.Author, utils.FormatBookType(book.Type)))
+	}
+
+	// Ensure directory exists
+	if err := os.MkdirAll(filepath.Dir(filePath), constants.DirPermissions); err != nil {
+		return fmt.Errorf("failed to create directory: %v", err)
+	}
+
+	// Write to file
+	if err := os.WriteFile(filePath, []byte(md.String()), constants.FilePermissions); err != nil {
+		return fmt.Errorf("failed to write timeline file: %v", err)
+	}
+
+	return nil
+}
+
+// ExportNotes exports a Markdown reading journal of just titles and notes
+// Books are ordered by the date they were added, and books without notes are left out
+func (s *BackupService) ExportNotes(books []models.Book, filePath string) error {
+	// Sort a copy oldest first so the caller's slice order is left untouched
+	sorted := make([]models.Book, len(books))
+	copy(sorted, books)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].CreatedAt.Before(sorted[j].CreatedAt)
+	})
+
+	var md strings.Builder
+	md.WriteString("# Reading Journal\n")
+	for _, book := range sorted {
+		notes := strings.TrimSpace(book.Notes)
+		if notes == "" {
+			continue
+		}
+		md.WriteString(fmt.Sprintf("\n## %s\n\n%s\n", book.Title, notes))
+	}
+
+	// Ensure directory exists
+	if err := os.MkdirAll(filepath.Dir(filePath), constants.DirPermissions); err != nil {
+		return fmt.Errorf("failed to create directory: %v", err)
+	}
+
+	// Write to file
+	if err := os.WriteFile(filePath, []byte(md.String()), constants.FilePermissions); err != nil {
+		return fmt.Errorf("failed to write journal file: %v", err)
+	}
+
+	return nil
+}
+
+// calibreCSVHeader lists the columns Calibre's CSV import recognizes
+var calibreCSVHeader = []string{"title", "authors", "tags", "comments", "pubdate"}
+
+// calibreWishlistTag tags books that are on the wishlist rather than owned
+const calibreWishlistTag = "wishlist"
+
+// ExportToCalibreCSV exports books as a CSV file using Calibre's import column names
+// Notes become comments, and wishlist books are tagged "wishlist". Libros has no publication
+// year, so that column is left empty
+func (s *BackupService) ExportToCalibreCSV(books []models.Book, filePath string) error {
+	rows := make([][]string, 0, len(books))
+	for _, book := range books {
+		tags := ""
+		if !book.Owned {
+			tags = calibreWishlistTag
+		}
+		rows = append(rows, []string{book.Title, book.Author, tags, book.Notes, ""})
+	}
+	return writeCSV(calibreCSVHeader, rows, filePath)
+}
+
+// goodreadsCSVHeader lists the columns Goodreads' CSV import recognizes
+var goodreadsCSVHeader = []string{"Title", "Author", "ISBN", "My Rating", "Date Read", "Bookshelves"}
+
+// Goodreads shelves for the book currently being read and for wishlist books
+const (
+	goodreadsReadingShelf  = "currently-reading"
+	goodreadsWishlistShelf = "to-read"
+)
+
+// ExportToGoodreadsCSV exports books as a CSV file using Goodreads' import column names
+// Libros has no ISBN, rating or date read, so those columns are left blank rather than
+// zero. The book marked as currently reading goes on Goodreads' currently-reading shelf,
+// and other wishlist books go on the to-read shelf.
+func (s *BackupService) ExportToGoodreadsCSV(books []models.Book, filePath string) error {
+	rows := make([][]string, 0, len(books))
+	for _, book := range books {
+		shelf := ""
+		if book.Reading {
+			shelf = goodreadsReadingShelf
+		} else if !book.Owned {
+			shelf = goodreadsWishlistShelf
+		}
+		rows = append(rows, []string{book.Title, book.Author, "", "", "", shelf})
+	}
+	return writeCSV(goodreadsCSVHeader, rows, filePath)
+}
+
+// writeCSV writes a header and rows to a CSV file, creating its directory if needed
+func writeCSV(header []string, rows [][]string, filePath string) error {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(header); err != nil {
+		return fmt.Errorf("failed to write CSV header: %v", err)
+	}
+	for _, row := range rows {
+		if err := w.Write(row); err != nil {
+			return fmt.Errorf("failed to write CSV row: %v", err)
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return fmt.Errorf("failed to write CSV data: %v", err)
+	}
+
+	// Ensure directory exists
+	if err := os.MkdirAll(filepath.Dir(filePath), constants.DirPermissions); err != nil {
+		return fmt.Errorf("failed to create directory: %v", err)
+	}
+
+	// Write to file
+	if err := os.WriteFile(filePath, buf.Bytes(), constants.FilePermissions); err != nil {
+		return fmt.Errorf("failed to write CSV file: %v", err)
+	}
+
+	return nil
+}
+
+// VerifyJSONExport reads a JSON export, full or limited to chosen fields, and counts its books
+// A file that is truncated, is not JSON or lacks the total_books and books fields is an error;
+// a file whose count does not match its books is reported through ExportVerification.Complete
+func (s *BackupService) VerifyJSONExport(filePath string) (models.ExportVerification, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return models.ExportVerification{}, fmt.Errorf("failed to read export: %v", err)
+	}
+	if bytes.HasPrefix(data, []byte(encryptedHeader)) {
+		return models.ExportVerification{}, fmt.Errorf("encrypted exports cannot be verified without the passphrase; import the file to check it")
+	}
+
+	// Books are left undecoded, so exports limited to some fields verify too
+	var export struct {
+		TotalBooks *int              `json:"total_books"`
+		Books      []json.RawMessage `json:"books"`
+	}
+	if err := json.Unmarshal(data, &export); err != nil {
+		return models.ExportVerification{}, fmt.Errorf("export is not valid JSON and may be truncated: %v", err)
+	}
+	if export.TotalBooks == nil || export.Books == nil {
+		return models.ExportVerification{}, fmt.Errorf("not a Libros JSON export: total_books or books is missing")
+	}
+
+	return models.ExportVerification{TotalBooks: *export.TotalBooks, BooksFound: len(export.Books)}, nil
+}
+
+// ChecksumExt is appended to an exported file's name to name its checksum manifest
+const ChecksumExt = ".sha256"
+
+// WriteChecksum computes the SHA-256 of a file and saves it alongside as filePath + ".sha256"
+// The manifest uses the sha256sum format, so `sha256sum -c books.json.sha256` verifies the export
+// It returns the path of the manifest
+func (s *BackupService) WriteChecksum(filePath string) (string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", fmt.Errorf("failed to open file for checksum: %v", err)
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", fmt.Errorf("failed to compute checksum: %v", err)
+	}
+
+	manifestPath := filePath + ChecksumExt
+	manifest := fmt.Sprintf("%x  %s\n", hash.Sum(nil), filepath.Base(filePath))
+	if err := os.WriteFile(manifestPath, []byte(manifest), constants.FilePermissions); err != nil {
+		return "", fmt.Errorf("failed to write checksum file: %v", err)
+	}
+
+	return manifestPath, nil
+}
+
 // BackupDatabase creates a backup copy of the database file
 func (s *BackupService) BackupDatabase(sourcePath, destPath string) error {
 	// Read source file
@@ -0,0 +1,89 @@
+// Package browser opens URLs in the user's default web browser, and local
+// folders in the file manager, since the platform openers handle both.
+// It shells out to the platform's own opener, and the command runner can be
+// replaced so callers and tests never need to launch a real browser.
+package browser
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// ErrUnsupportedPlatform is returned when there is no known way to open a URL on this OS
+var ErrUnsupportedPlatform = errors.New("opening a browser is not supported on this platform")
+
+// ErrNoURL is returned when asked to open an empty URL
+var ErrNoURL = errors.New("no URL to open")
+
+// ErrNoDisplay is returned when there is no graphical session to open a URL in
+var ErrNoDisplay = errors.New("no display available to open a browser")
+
+// Runner starts a command with the given arguments without waiting for it to finish
+type Runner func(name string, args ...string) error
+
+// Opener opens URLs using the command for a particular operating system
+type Opener struct {
+	GOOS   string                  // Operating system whose opener command is used
+	Run    Runner                  // Starts the opener command
+	Getenv func(key string) string // Reads the environment to detect a headless session; nil skips the check
+}
+
+// New returns an Opener for the current operating system that starts real commands
+func New() Opener {
+	return Opener{GOOS: runtime.GOOS, Run: startCommand, Getenv: os.Getenv}
+}
+
+// Open launches the default browser on url, or the file manager when url is a directory path
+// It returns ErrNoURL for a blank url, ErrUnsupportedPlatform when the OS has no known opener
+// and ErrNoDisplay in a headless session
+func (o Opener) Open(url string) error {
+	url = strings.TrimSpace(url)
+	if url == "" {
+		return ErrNoURL
+	}
+	name, args, err := Command(o.GOOS, url)
+	if err != nil {
+		return err
+	}
+	if o.Getenv != nil && Headless(o.GOOS, o.Getenv) {
+		return ErrNoDisplay
+	}
+	if err := o.Run(name, args...); err != nil {
+		return fmt.Errorf("failed to open browser: %v", err)
+	}
+	return nil
+}
+
+// Command returns the program and arguments that open url on the given OS
+func Command(goos, url string) (string, []string, error) {
+	switch goos {
+	case "linux", "freebsd", "openbsd", "netbsd":
+		return "xdg-open", []string{url}, nil
+	case "darwin":
+		return "open", []string{url}, nil
+	case "windows":
+		return "rundll32", []string{"url.dll,FileProtocolHandler", url}, nil
+	default:
+		return "", nil, ErrUnsupportedPlatform
+	}
+}
+
+// Headless reports whether a Unix-like OS has no X11 or Wayland display, as over SSH
+// macOS and Windows always have a desktop, so they are never headless
+func Headless(goos string, getenv func(key string) string) bool {
+	switch goos {
+	case "linux", "freebsd", "openbsd", "netbsd":
+		return getenv("DISPLAY") == "" && getenv("WAYLAND_DISPLAY") == ""
+	default:
+		return false
+	}
+}
+
+// startCommand starts the command and returns once it is running
+func startCommand(name string, args ...string) error {
+	return exec.Command(name, args...).Start()
+}
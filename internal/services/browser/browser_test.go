@@ -0,0 +1,134 @@
+package browser
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// TestCommand tests the opener command chosen for each operating system
+func TestCommand(t *testing.T) {
+	url := "https://example.com/book"
+	tests := []struct {
+		goos     string
+		wantName string
+		wantArgs []string
+	}{
+		{"linux", "xdg-open", []string{url}},
+		{"freebsd", "xdg-open", []string{url}},
+		{"darwin", "open", []string{url}},
+		{"windows", "rundll32", []string{"url.dll,FileProtocolHandler", url}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.goos, func(t *testing.T) {
+			name, args, err := Command(tt.goos, url)
+			if err != nil {
+				t.Fatalf("Command(%q) returned error: %v", tt.goos, err)
+			}
+			if name != tt.wantName || !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("Command(%q) = %s %v, want %s %v", tt.goos, name, args, tt.wantName, tt.wantArgs)
+			}
+		})
+	}
+
+	if _, _, err := Command("plan9", url); !errors.Is(err, ErrUnsupportedPlatform) {
+		t.Errorf("Command(plan9) error = %v, want ErrUnsupportedPlatform", err)
+	}
+}
+
+// TestOpener_Open tests that Open passes the platform command to the runner
+// and reports blank URLs, unsupported platforms and runner failures
+func TestOpener_Open(t *testing.T) {
+	var gotName string
+	var gotArgs []string
+	recorder := func(name string, args ...string) error {
+		gotName, gotArgs = name, args
+		return nil
+	}
+
+	o := Opener{GOOS: "darwin", Run: recorder}
+	if err := o.Open("  https://example.com  "); err != nil {
+		t.Fatalf("Open() returned error: %v", err)
+	}
+	if gotName != "open" || !reflect.DeepEqual(gotArgs, []string{"https://example.com"}) {
+		t.Errorf("Open() ran %s %v, want open [https://example.com]", gotName, gotArgs)
+	}
+
+	gotName = ""
+	if err := o.Open("   "); !errors.Is(err, ErrNoURL) {
+		t.Errorf("Open(blank) error = %v, want ErrNoURL", err)
+	}
+	if gotName != "" {
+		t.Error("Open(blank) should not run a command")
+	}
+
+	unsupported := Opener{GOOS: "plan9", Run: recorder}
+	if err := unsupported.Open("https://example.com"); !errors.Is(err, ErrUnsupportedPlatform) {
+		t.Errorf("Open() on unsupported platform error = %v, want ErrUnsupportedPlatform", err)
+	}
+
+	failing := Opener{GOOS: "linux", Run: func(string, ...string) error { return errors.New("not found") }}
+	if err := failing.Open("https://example.com"); err == nil {
+		t.Error("Open() should report a runner failure")
+	}
+}
+
+// TestHeadless tests that only Unix-like systems without a display are headless
+func TestHeadless(t *testing.T) {
+	env := func(vars map[string]string) func(string) string {
+		return func(key string) string { return vars[key] }
+	}
+	tests := []struct {
+		name string
+		goos string
+		vars map[string]string
+		want bool
+	}{
+		{"linux without display", "linux", nil, true},
+		{"linux with X11", "linux", map[string]string{"DISPLAY": ":0"}, false},
+		{"linux with Wayland", "linux", map[string]string{"WAYLAND_DISPLAY": "wayland-0"}, false},
+		{"freebsd without display", "freebsd", nil, true},
+		{"darwin", "darwin", nil, false},
+		{"windows", "windows", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Headless(tt.goos, env(tt.vars)); got != tt.want {
+				t.Errorf("Headless(%q) = %v, want %v", tt.goos, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestOpener_OpenHeadless tests that Open reports ErrNoDisplay instead of
+// running the opener when there is no display, and opens directories otherwise
+func TestOpener_OpenHeadless(t *testing.T) {
+	ran := false
+	recorder := func(string, ...string) error {
+		ran = true
+		return nil
+	}
+
+	headless := Opener{GOOS: "linux", Run: recorder, Getenv: func(string) string { return "" }}
+	if err := headless.Open("/home/reader/.libros/exports"); !errors.Is(err, ErrNoDisplay) {
+		t.Errorf("Open() without a display error = %v, want ErrNoDisplay", err)
+	}
+	if ran {
+		t.Error("Open() without a display should not run a command")
+	}
+
+	desktop := Opener{GOOS: "linux", Run: recorder, Getenv: func(key string) string {
+		if key == "DISPLAY" {
+			return ":0"
+		}
+		return ""
+	}}
+	if err := desktop.Open("/home/reader/.libros/exports"); err != nil {
+		t.Errorf("Open() with a display returned error: %v", err)
+	}
+	if !ran {
+		t.Error("Open() with a display should run the opener")
+	}
+}
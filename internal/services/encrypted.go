@@ -0,0 +1,136 @@
+package services
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/papadavis47/libros/internal/constants"
+	"github.com/papadavis47/libros/internal/models"
+)
+
+// EncryptedExt is the extension of an encrypted JSON export, e.g. books.json.enc
+const EncryptedExt = ".enc"
+
+// An encrypted export is the header, a random salt and nonce, then the
+// AES-256-GCM sealed JSON. The header is authenticated with the JSON so a
+// file from a future format version is rejected rather than misread.
+const (
+	encryptedHeader     = "LIBROS-ENC-1\n"
+	encryptedSaltSize   = 16
+	encryptedKeySize    = 32 // AES-256
+	encryptedIterations = 600000
+)
+
+// ExportEncryptedJSON exports books as JSON encrypted with a key derived from passphrase
+// The file can only be read back by ImportEncryptedJSON with the same passphrase
+func (s *BackupService) ExportEncryptedJSON(books []models.Book, filePath, passphrase string) error {
+	if passphrase == "" {
+		return fmt.Errorf("a passphrase is required")
+	}
+
+	backupData := BackupData{
+		ExportDate: time.Now(),
+		TotalBooks: len(books),
+		Books:      books,
+	}
+	jsonData, err := json.Marshal(backupData)
+	if err != nil {
+		return fmt.Errorf("failed to marshal data to JSON: %v", err)
+	}
+
+	salt := make([]byte, encryptedSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return fmt.Errorf("failed to generate salt: %v", err)
+	}
+	gcm, err := newPassphraseCipher(passphrase, salt)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return fmt.Errorf("failed to generate nonce: %v", err)
+	}
+
+	var data bytes.Buffer
+	data.WriteString(encryptedHeader)
+	data.Write(salt)
+	data.Write(nonce)
+	data.Write(gcm.Seal(nil, nonce, jsonData, []byte(encryptedHeader)))
+
+	// Ensure directory exists
+	if err := os.MkdirAll(filepath.Dir(filePath), constants.DirPermissions); err != nil {
+		return fmt.Errorf("failed to create directory: %v", err)
+	}
+
+	// Write to file
+	if err := os.WriteFile(filePath, data.Bytes(), constants.FilePermissions); err != nil {
+		return fmt.Errorf("failed to write encrypted file: %v", err)
+	}
+
+	return nil
+}
+
+// ImportEncryptedJSON reads the books from a file written by ExportEncryptedJSON
+// A wrong passphrase, or a file that has been changed, reports "incorrect passphrase"
+func (s *BackupService) ImportEncryptedJSON(filePath, passphrase string) ([]models.Book, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	rest, ok := bytes.CutPrefix(data, []byte(encryptedHeader))
+	if !ok {
+		return nil, fmt.Errorf("not an encrypted Libros export")
+	}
+	if len(rest) < encryptedSaltSize {
+		return nil, fmt.Errorf("encrypted file is incomplete")
+	}
+	salt, rest := rest[:encryptedSaltSize], rest[encryptedSaltSize:]
+	gcm, err := newPassphraseCipher(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) < gcm.NonceSize() {
+		return nil, fmt.Errorf("encrypted file is incomplete")
+	}
+	nonce, sealed := rest[:gcm.NonceSize()], rest[gcm.NonceSize():]
+
+	// GCM cannot tell a wrong key from tampered data; a wrong key is far more likely
+	jsonData, err := gcm.Open(nil, nonce, sealed, []byte(encryptedHeader))
+	if err != nil {
+		return nil, fmt.Errorf("incorrect passphrase")
+	}
+
+	var backupData BackupData
+	if err := json.Unmarshal(jsonData, &backupData); err != nil {
+		return nil, fmt.Errorf("failed to read exported books: %v", err)
+	}
+	return backupData.Books, nil
+}
+
+// newPassphraseCipher derives an AES-256 key from the passphrase and salt with
+// PBKDF2-SHA256 and returns the GCM cipher for it
+func newPassphraseCipher(passphrase string, salt []byte) (cipher.AEAD, error) {
+	key, err := pbkdf2.Key(sha256.New, passphrase, salt, encryptedIterations, encryptedKeySize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive key: %v", err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %v", err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %v", err)
+	}
+	return gcm, nil
+}
@@ -0,0 +1,119 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/papadavis47/libros/internal/models"
+	"github.com/papadavis47/libros/internal/validation"
+)
+
+// TitleListSeparator separates the title from the author on each line of a title list
+const TitleListSeparator = " - "
+
+// ParseTitleList parses a plain-text list with one "Title - Author" per line
+// Blank lines are ignored. Lines without the separator, or whose title or author
+// fails validation, are returned as skipped 1-based line numbers.
+// The split is on the last separator so titles may contain " - " themselves.
+func ParseTitleList(content string) ([]models.Book, []int) {
+	var books []models.Book
+	var skipped []int
+
+	for i, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		sep := strings.LastIndex(line, TitleListSeparator)
+		if sep < 0 {
+			skipped = append(skipped, i+1)
+			continue
+		}
+		title := strings.TrimSpace(line[:sep])
+		author := strings.TrimSpace(line[sep+len(TitleListSeparator):])
+		if validation.ValidateTitle(title) != nil || validation.ValidateAuthor(author) != nil {
+			skipped = append(skipped, i+1)
+			continue
+		}
+
+		books = append(books, models.Book{
+			Title:  title,
+			Author: author,
+			Type:   models.Paperback,
+			Owned:  true,
+		})
+	}
+
+	return books, skipped
+}
+
+// BulkEntrySeparator separates the title, author and type on each line of a bulk entry
+const BulkEntrySeparator = "|"
+
+// BulkRow is one non-blank line of a bulk entry, parsed into a book
+// Err explains why the line cannot be added; rows without an error are ready to save
+type BulkRow struct {
+	Line int         // 1-based line number in the entry
+	Text string      // The line as typed, trimmed
+	Book models.Book // Book parsed from the line, owned and without notes
+	Err  error       // Why the row is malformed, nil if it can be added
+}
+
+// ParseBulkEntry parses pasted lines of "Title | Author | Type" into rows for review
+// Blank lines are ignored. The type may be left off, in which case the book is a
+// paperback; otherwise it is matched by models.ParseBookType. Every other line gets
+// a row, so a malformed line can be shown with its problem next to the valid ones.
+func ParseBulkEntry(content string) []BulkRow {
+	var rows []BulkRow
+
+	for i, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		row := BulkRow{Line: i + 1, Text: line}
+
+		fields := strings.Split(line, BulkEntrySeparator)
+		if len(fields) < 2 || len(fields) > 3 {
+			row.Err = fmt.Errorf("expected Title %s Author %s Type", BulkEntrySeparator, BulkEntrySeparator)
+			rows = append(rows, row)
+			continue
+		}
+		title := strings.TrimSpace(fields[0])
+		author := strings.TrimSpace(fields[1])
+		bookType := models.Paperback
+		if len(fields) == 3 && strings.TrimSpace(fields[2]) != "" {
+			var ok bool
+			if bookType, ok = models.ParseBookType(fields[2]); !ok {
+				row.Err = fmt.Errorf("unknown type %q", strings.TrimSpace(fields[2]))
+			}
+		}
+		if err := validation.ValidateTitle(title); err != nil {
+			row.Err = err
+		} else if err := validation.ValidateAuthor(author); err != nil {
+			row.Err = err
+		}
+
+		row.Book = models.Book{
+			Title:  title,
+			Author: author,
+			Type:   bookType,
+			Owned:  true,
+		}
+		rows = append(rows, row)
+	}
+
+	return rows
+}
+
+// ValidBulkBooks returns the books of the rows that can be added, in entry order
+func ValidBulkBooks(rows []BulkRow) []models.Book {
+	var books []models.Book
+	for _, row := range rows {
+		if row.Err == nil {
+			books = append(books, row.Book)
+		}
+	}
+	return books
+}
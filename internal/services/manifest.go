@@ -0,0 +1,32 @@
+package services
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/papadavis47/libros/internal/constants"
+	"github.com/papadavis47/libros/internal/models"
+)
+
+// ManifestName is the file in the exports directory that records every successful export
+const ManifestName = "manifest.log"
+
+// AppendManifest adds entry as a line at the end of the manifest at manifestPath,
+// creating the file and its directory when needed. Each line is written with a
+// single append, so exports finishing at the same time cannot interleave lines.
+func (s *BackupService) AppendManifest(manifestPath string, entry models.ManifestEntry) error {
+	if err := os.MkdirAll(filepath.Dir(manifestPath), constants.DirPermissions); err != nil {
+		return fmt.Errorf("failed to create directory: %v", err)
+	}
+
+	file, err := os.OpenFile(manifestPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, constants.FilePermissions)
+	if err != nil {
+		return fmt.Errorf("failed to open manifest: %v", err)
+	}
+	if _, err := file.WriteString(entry.String() + "\n"); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write manifest: %v", err)
+	}
+	return file.Close()
+}
@@ -1,10 +1,14 @@
 package services_test
 
 import (
+	"crypto/sha256"
+	"encoding/csv"
 	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -274,4 +278,915 @@ func TestBackupService_BackupDatabase(t *testing.T) {
 			t.Error("BackupDatabase should fail with nonexistent source")
 		}
 	})
-}
\ No newline at end of file
+}
+// exportTestBooks returns a small, fixed book collection for export tests
+// One book has long notes (to exercise wrapping) and one has none
+func exportTestBooks() []models.Book {
+	return []models.Book{
+		{
+			ID:        1,
+			Title:     "The Go Programming Language",
+			Author:    "Alan Donovan",
+			Type:      models.Paperback,
+			Notes:     "Excellent reference book for Go developers that covers the language in depth with many practical examples and exercises",
+			Owned:     true,
+			CreatedAt: time.Date(2023, 1, 15, 10, 30, 0, 0, time.UTC),
+			UpdatedAt: time.Date(2023, 1, 20, 14, 45, 0, 0, time.UTC),
+		},
+		{
+			ID:        2,
+			Title:     "Clean Code",
+			Author:    "Robert C. Martin",
+			Type:      models.Hardback,
+			Notes:     "",
+			Owned:     true,
+			CreatedAt: time.Date(2023, 2, 10, 9, 15, 0, 0, time.UTC),
+			UpdatedAt: time.Date(2023, 2, 10, 9, 15, 0, 0, time.UTC),
+		},
+	}
+}
+
+// TestBackupService_ExportToText tests plain-text export functionality
+func TestBackupService_ExportToText(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "libros_test_txt")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	service := services.NewBackupService()
+	testBooks := exportTestBooks()
+
+	t.Run("SuccessfulExport", func(t *testing.T) {
+		exportPath := filepath.Join(tempDir, "books.txt")
+
+		if err := service.ExportToText(testBooks, exportPath); err != nil {
+			t.Fatalf("ExportToText failed: %v", err)
+		}
+
+		content, err := os.ReadFile(exportPath)
+		if err != nil {
+			t.Fatalf("Failed to read exported file: %v", err)
+		}
+		contentStr := string(content)
+
+		expectedStrings := []string{
+			"BOOK COLLECTION EXPORT",
+			"Total Books: 2",
+			"1. The Go Programming Language",
+			"Author:  Alan Donovan",
+			"Type:    Paperback",
+			"Added:   January 15th, 2023",
+			"2. Clean Code",
+			"Type:    Hardback",
+		}
+		for _, expected := range expectedStrings {
+			if !strings.Contains(contentStr, expected) {
+				t.Errorf("Text export should contain %q", expected)
+			}
+		}
+
+		// Plain text must not contain markdown formatting
+		if strings.Contains(contentStr, "**") || strings.Contains(contentStr, "## ") {
+			t.Error("Text export should not contain markdown formatting")
+		}
+
+		// Notes should be wrapped so no line exceeds the wrap width
+		for _, line := range strings.Split(contentStr, "\n") {
+			if len(line) > 60 {
+				t.Errorf("Line exceeds wrap width (%d chars): %q", len(line), line)
+			}
+		}
+
+		// Only the book with notes should have a notes section
+		if count := strings.Count(contentStr, "Notes:"); count != 1 {
+			t.Errorf("Expected 1 notes section, got %d", count)
+		}
+	})
+
+	t.Run("ExportEmptyList", func(t *testing.T) {
+		exportPath := filepath.Join(tempDir, "empty.txt")
+
+		if err := service.ExportToText([]models.Book{}, exportPath); err != nil {
+			t.Fatalf("ExportToText with empty list failed: %v", err)
+		}
+
+		content, err := os.ReadFile(exportPath)
+		if err != nil {
+			t.Fatalf("Failed to read exported file: %v", err)
+		}
+		if !strings.Contains(string(content), "Total Books: 0") {
+			t.Error("Empty text export should report zero books")
+		}
+	})
+}
+
+// TestBackupService_ExportTimeline tests the chronological reading timeline export
+func TestBackupService_ExportTimeline(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "libros_test_timeline")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	service := services.NewBackupService()
+
+	t.Run("GroupsByMonthInOrder", func(t *testing.T) {
+		// Books are passed newest first, as LoadBooks returns them
+		testBooks := exportTestBooks()
+		books := []models.Book{
+			{
+				ID:        3,
+				Title:     "Refactoring",
+				Author:    "Martin Fowler",
+				Type:      models.Digital,
+				CreatedAt: time.Date(2023, 2, 20, 8, 0, 0, 0, time.UTC),
+			},
+			testBooks[1],
+			testBooks[0],
+		}
+		exportPath := filepath.Join(tempDir, "timeline.md")
+
+		if err := service.ExportTimeline(books, exportPath); err != nil {
+			t.Fatalf("ExportTimeline failed: %v", err)
+		}
+
+		content, err := os.ReadFile(exportPath)
+		if err != nil {
+			t.Fatalf("Failed to read exported file: %v", err)
+		}
+		contentStr := string(content)
+
+		// Entries must appear oldest first, each under its month heading
+		ordered := []string{
+			"# Reading Timeline",
+			"## January 2023",
+			"Added *The Go Programming Language* by Alan Donovan (Paperback)",
+			"## February 2023",
+			"Added *Clean Code* by Robert C. Martin (Hardback)",
+			"Added *Refactoring* by Martin Fowler (Digital)",
+		}
+		last := -1
+		for _, expected := range ordered {
+			pos := strings.Index(contentStr, expected)
+			if pos == -1 {
+				t.Fatalf("Timeline should contain %q", expected)
+			}
+			if pos < last {
+				t.Errorf("%q appears out of chronological order", expected)
+			}
+			last = pos
+		}
+
+		// Each month heading should appear only once
+		if count := strings.Count(contentStr, "## February 2023"); count != 1 {
+			t.Errorf("Expected 1 February heading, got %d", count)
+		}
+
+		// The caller's slice must not be reordered
+		if books[0].Title != "Refactoring" {
+			t.Error("ExportTimeline should not modify the input slice")
+		}
+	})
+
+	t.Run("ExportEmptyList", func(t *testing.T) {
+		exportPath := filepath.Join(tempDir, "empty-timeline.md")
+
+		if err := service.ExportTimeline([]models.Book{}, exportPath); err != nil {
+			t.Fatalf("ExportTimeline with empty list failed: %v", err)
+		}
+
+		content, err := os.ReadFile(exportPath)
+		if err != nil {
+			t.Fatalf("Failed to read exported file: %v", err)
+		}
+		if strings.Contains(string(content), "## ") {
+			t.Error("Empty timeline should not contain any month headings")
+		}
+	})
+}
+
+// TestBackupService_ExportToJSONL tests newline-delimited JSON export functionality
+func TestBackupService_ExportToJSONL(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "libros_test_jsonl")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	service := services.NewBackupService()
+	testBooks := exportTestBooks()
+
+	t.Run("SuccessfulExport", func(t *testing.T) {
+		exportPath := filepath.Join(tempDir, "books.jsonl")
+
+		if err := service.ExportToJSONL(testBooks, exportPath); err != nil {
+			t.Fatalf("ExportToJSONL failed: %v", err)
+		}
+
+		content, err := os.ReadFile(exportPath)
+		if err != nil {
+			t.Fatalf("Failed to read exported file: %v", err)
+		}
+
+		// Every line must end with a newline, including the last
+		if !strings.HasSuffix(string(content), "\n") {
+			t.Error("JSONL export should end with a newline")
+		}
+
+		lines := strings.Split(strings.TrimSuffix(string(content), "\n"), "\n")
+		if len(lines) != len(testBooks) {
+			t.Fatalf("Expected %d lines, got %d", len(testBooks), len(lines))
+		}
+
+		// Each line is a complete book object in the original order
+		for i, line := range lines {
+			var book models.Book
+			if err := json.Unmarshal([]byte(line), &book); err != nil {
+				t.Fatalf("Line %d is not valid JSON: %v", i+1, err)
+			}
+			if book.ID != testBooks[i].ID || book.Title != testBooks[i].Title {
+				t.Errorf("Line %d = book %d %q, want book %d %q", i+1, book.ID, book.Title, testBooks[i].ID, testBooks[i].Title)
+			}
+		}
+	})
+
+	t.Run("ExportEmptyList", func(t *testing.T) {
+		exportPath := filepath.Join(tempDir, "empty.jsonl")
+
+		if err := service.ExportToJSONL([]models.Book{}, exportPath); err != nil {
+			t.Fatalf("ExportToJSONL with empty list failed: %v", err)
+		}
+
+		info, err := os.Stat(exportPath)
+		if err != nil {
+			t.Fatalf("Export file was not created: %v", err)
+		}
+		if info.Size() != 0 {
+			t.Errorf("Empty JSONL export should be zero bytes, got %d", info.Size())
+		}
+	})
+}
+
+// TestBackupService_ExportFields tests JSON and JSON Lines exports limited to chosen fields
+// Excluded fields are left out of the book objects, while the ID and title are always written
+func TestBackupService_ExportFields(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "libros_test_fields")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	service := services.NewBackupService()
+	testBooks := exportTestBooks()
+	fields := []string{services.FieldDates, services.FieldAuthor, "rating"}
+
+	// checkBook verifies a decoded book object has exactly the ID, title, author and dates
+	checkBook := func(t *testing.T, object map[string]interface{}, want models.Book) {
+		t.Helper()
+		for _, key := range []string{"ID", "Title", "Author", "CreatedAt", "UpdatedAt"} {
+			if _, ok := object[key]; !ok {
+				t.Errorf("Book object is missing %s: %v", key, object)
+			}
+		}
+		for _, key := range []string{"Type", "Notes", "Location", "Pinned", "Reading", "Owned", "rating"} {
+			if _, ok := object[key]; ok {
+				t.Errorf("Book object should not include %s: %v", key, object)
+			}
+		}
+		if object["Title"] != want.Title || object["Author"] != want.Author {
+			t.Errorf("Book object = %v, want %q by %q", object, want.Title, want.Author)
+		}
+	}
+
+	t.Run("JSON", func(t *testing.T) {
+		exportPath := filepath.Join(tempDir, "books.json")
+		if err := service.ExportToJSONFields(testBooks, fields, exportPath); err != nil {
+			t.Fatalf("ExportToJSONFields failed: %v", err)
+		}
+
+		content, err := os.ReadFile(exportPath)
+		if err != nil {
+			t.Fatalf("Failed to read exported file: %v", err)
+		}
+		var data struct {
+			TotalBooks int                      `json:"total_books"`
+			Fields     []string                 `json:"fields"`
+			Books      []map[string]interface{} `json:"books"`
+		}
+		if err := json.Unmarshal(content, &data); err != nil {
+			t.Fatalf("Export is not valid JSON: %v", err)
+		}
+		if data.TotalBooks != len(testBooks) || len(data.Books) != len(testBooks) {
+			t.Fatalf("Exported %d of %d books, want %d", len(data.Books), data.TotalBooks, len(testBooks))
+		}
+		// Unknown fields are dropped and the rest listed in export order
+		if strings.Join(data.Fields, ",") != "author,dates" {
+			t.Errorf("Fields = %v, want [author dates]", data.Fields)
+		}
+		for i, object := range data.Books {
+			checkBook(t, object, testBooks[i])
+		}
+	})
+
+	t.Run("JSONLines", func(t *testing.T) {
+		exportPath := filepath.Join(tempDir, "books.jsonl")
+		if err := service.ExportToJSONLFields(testBooks, fields, exportPath); err != nil {
+			t.Fatalf("ExportToJSONLFields failed: %v", err)
+		}
+
+		content, err := os.ReadFile(exportPath)
+		if err != nil {
+			t.Fatalf("Failed to read exported file: %v", err)
+		}
+		lines := strings.Split(strings.TrimSuffix(string(content), "\n"), "\n")
+		if len(lines) != len(testBooks) {
+			t.Fatalf("Expected %d lines, got %d", len(testBooks), len(lines))
+		}
+		for i, line := range lines {
+			var object map[string]interface{}
+			if err := json.Unmarshal([]byte(line), &object); err != nil {
+				t.Fatalf("Line %d is not valid JSON: %v", i+1, err)
+			}
+			checkBook(t, object, testBooks[i])
+		}
+	})
+
+	t.Run("AllFieldsMatchFullExport", func(t *testing.T) {
+		exportPath := filepath.Join(tempDir, "all.jsonl")
+		if err := service.ExportToJSONLFields(testBooks, services.ExportFields, exportPath); err != nil {
+			t.Fatalf("ExportToJSONLFields failed: %v", err)
+		}
+		fullPath := filepath.Join(tempDir, "full.jsonl")
+		if err := service.ExportToJSONL(testBooks, fullPath); err != nil {
+			t.Fatalf("ExportToJSONL failed: %v", err)
+		}
+
+		selected, _ := os.ReadFile(exportPath)
+		full, _ := os.ReadFile(fullPath)
+		if string(selected) != string(full) {
+			t.Errorf("Export with every field =\n%s\nwant the full export\n%s", selected, full)
+		}
+	})
+}
+
+// TestBackupService_ExportToCalibreCSV tests the Calibre-compatible CSV export
+func TestBackupService_ExportToCalibreCSV(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "libros_test_calibre")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	service := services.NewBackupService()
+	testBooks := exportTestBooks()
+	testBooks[1].Title = `Clean Code, "2nd" Printing`
+	testBooks[1].Owned = false
+
+	exportPath := filepath.Join(tempDir, "books-calibre.csv")
+	if err := service.ExportToCalibreCSV(testBooks, exportPath); err != nil {
+		t.Fatalf("ExportToCalibreCSV failed: %v", err)
+	}
+
+	file, err := os.Open(exportPath)
+	if err != nil {
+		t.Fatalf("Failed to open exported file: %v", err)
+	}
+	defer file.Close()
+
+	records, err := csv.NewReader(file).ReadAll()
+	if err != nil {
+		t.Fatalf("Exported file is not valid CSV: %v", err)
+	}
+	if len(records) != len(testBooks)+1 {
+		t.Fatalf("Expected %d records including header, got %d", len(testBooks)+1, len(records))
+	}
+
+	// Header uses Calibre's column names
+	if got := strings.Join(records[0], ","); got != "title,authors,tags,comments,pubdate" {
+		t.Errorf("Header = %q, want Calibre column names", got)
+	}
+
+	// Rows map title, author and notes, and survive commas and quotes
+	for i, book := range testBooks {
+		row := records[i+1]
+		if row[0] != book.Title || row[1] != book.Author || row[3] != book.Notes {
+			t.Errorf("Row %d = %q, want title %q, author %q, notes %q", i+1, row, book.Title, book.Author, book.Notes)
+		}
+		// Only wishlist books are tagged
+		if wantTags := map[bool]string{true: "", false: "wishlist"}[book.Owned]; row[2] != wantTags {
+			t.Errorf("Row %d tags = %q, want %q", i+1, row[2], wantTags)
+		}
+	}
+}
+
+// TestBackupService_ExportStats tests exporting collection statistics as JSON
+// Keys are written in a fixed order so repeated exports can be diffed
+func TestBackupService_ExportStats(t *testing.T) {
+	tempDir := t.TempDir()
+	service := services.NewBackupService()
+
+	stats := models.ComputeStats(exportTestBooks())
+	exportPath := filepath.Join(tempDir, "stats.json")
+	if err := service.ExportStats(stats, exportPath); err != nil {
+		t.Fatalf("ExportStats failed: %v", err)
+	}
+
+	content, err := os.ReadFile(exportPath)
+	if err != nil {
+		t.Fatalf("Failed to read exported file: %v", err)
+	}
+
+	var exported services.StatsData
+	if err := json.Unmarshal(content, &exported); err != nil {
+		t.Fatalf("Failed to parse exported JSON: %v", err)
+	}
+	if exported.TotalBooks != 2 || exported.ByStatus.Owned != 2 {
+		t.Errorf("Exported totals = %d books, %d owned, want 2 and 2", exported.TotalBooks, exported.ByStatus.Owned)
+	}
+	if exported.AverageNoteWords != stats.AverageNoteWords() {
+		t.Errorf("AverageNoteWords = %d, want %d", exported.AverageNoteWords, stats.AverageNoteWords())
+	}
+
+	// Fields appear in declaration order, and by_type keys are sorted
+	text := string(content)
+	order := []string{`"export_date"`, `"average_note_words"`, `"total_books"`, `"by_type"`, `"by_status"`, `"first_added"`, `"last_added"`}
+	last := -1
+	for _, key := range order {
+		index := strings.Index(text, key)
+		if index < last {
+			t.Errorf("Key %s is out of order in %s", key, text)
+		}
+		last = index
+	}
+}
+
+// TestParseTitleList tests parsing a "Title - Author" list for import
+// Blank lines are ignored and unparseable lines are reported by line number
+func TestParseTitleList(t *testing.T) {
+	content := "Dune - Frank Herbert\n" +
+		"\n" +
+		"No separator here\n" +
+		"  Star Wars - A New Hope - George Lucas  \n" +
+		" - Missing Title\n" +
+		"Missing Author - \n" +
+		"Beloved - Toni Morrison"
+
+	books, skipped := services.ParseTitleList(content)
+
+	want := []struct{ title, author string }{
+		{"Dune", "Frank Herbert"},
+		{"Star Wars - A New Hope", "George Lucas"},
+		{"Beloved", "Toni Morrison"},
+	}
+	if len(books) != len(want) {
+		t.Fatalf("Expected %d books, got %d: %+v", len(want), len(books), books)
+	}
+	for i, w := range want {
+		if books[i].Title != w.title || books[i].Author != w.author {
+			t.Errorf("Book %d = %q by %q, want %q by %q", i, books[i].Title, books[i].Author, w.title, w.author)
+		}
+		if books[i].Type != models.Paperback || books[i].Notes != "" || !books[i].Owned {
+			t.Errorf("Book %d should be an owned paperback with empty notes, got %+v", i, books[i])
+		}
+	}
+
+	wantSkipped := []int{3, 5, 6}
+	if fmt.Sprint(skipped) != fmt.Sprint(wantSkipped) {
+		t.Errorf("Skipped lines = %v, want %v", skipped, wantSkipped)
+	}
+}
+
+// TestParseBulkEntry tests parsing pasted "Title | Author | Type" lines for bulk adding
+// Malformed lines keep their row with an error, and only valid rows are added
+func TestParseBulkEntry(t *testing.T) {
+	content := "Dune | Frank Herbert | Hardback\n" +
+		"\n" +
+		"  Beloved | Toni Morrison  \n" +
+		"No separator here\n" +
+		"Neuromancer | William Gibson | vinyl\n" +
+		" | Missing Title | audio\n" +
+		"Too | Many | Fields | Here\n" +
+		"Snow Crash|Neal Stephenson|DIGITAL"
+
+	rows := services.ParseBulkEntry(content)
+
+	want := []struct {
+		line     int
+		title    string
+		bookType models.BookType
+		valid    bool
+	}{
+		{1, "Dune", models.Hardback, true},
+		{3, "Beloved", models.Paperback, true},
+		{4, "", "", false},
+		{5, "Neuromancer", "", false},
+		{6, "", "", false},
+		{7, "", "", false},
+		{8, "Snow Crash", models.Digital, true},
+	}
+	if len(rows) != len(want) {
+		t.Fatalf("Expected %d rows, got %d: %+v", len(want), len(rows), rows)
+	}
+	for i, w := range want {
+		row := rows[i]
+		if row.Line != w.line {
+			t.Errorf("Row %d line = %d, want %d", i, row.Line, w.line)
+		}
+		if (row.Err == nil) != w.valid {
+			t.Errorf("Row %d (%q) error = %v, want valid %v", i, row.Text, row.Err, w.valid)
+		}
+		if w.valid && (row.Book.Title != w.title || row.Book.Type != w.bookType || !row.Book.Owned) {
+			t.Errorf("Row %d book = %+v, want owned %s %q", i, row.Book, w.bookType, w.title)
+		}
+	}
+
+	books := services.ValidBulkBooks(rows)
+	if len(books) != 3 || books[0].Title != "Dune" || books[1].Author != "Toni Morrison" || books[2].Title != "Snow Crash" {
+		t.Errorf("ValidBulkBooks() = %+v, want Dune, Beloved and Snow Crash", books)
+	}
+}
+
+// TestBackupService_ExportNotes tests the reading journal export
+// Books appear oldest first with their notes, and books without notes are left out
+func TestBackupService_ExportNotes(t *testing.T) {
+	tempDir := t.TempDir()
+	service := services.NewBackupService()
+
+	// Books are passed newest first, as LoadBooks returns them
+	testBooks := exportTestBooks()
+	books := []models.Book{
+		{ID: 3, Title: "Refactoring", Author: "Martin Fowler", Notes: "  Small steps, always green.  ", CreatedAt: time.Date(2023, 3, 1, 8, 0, 0, 0, time.UTC)},
+		testBooks[1], // Clean Code has no notes
+		testBooks[0],
+	}
+
+	exportPath := filepath.Join(tempDir, "books-journal.md")
+	if err := service.ExportNotes(books, exportPath); err != nil {
+		t.Fatalf("ExportNotes failed: %v", err)
+	}
+
+	content, err := os.ReadFile(exportPath)
+	if err != nil {
+		t.Fatalf("Failed to read exported file: %v", err)
+	}
+
+	want := "# Reading Journal\n" +
+		"\n## The Go Programming Language\n\n" + testBooks[0].Notes + "\n" +
+		"\n## Refactoring\n\nSmall steps, always green.\n"
+	if string(content) != want {
+		t.Errorf("Journal content = %q, want %q", content, want)
+	}
+}
+
+// TestBackupService_ExportToGoodreadsCSV tests the Goodreads-compatible CSV export
+// Fields Libros does not track must be blank rather than zero
+func TestBackupService_ExportToGoodreadsCSV(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "libros_test_goodreads")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	service := services.NewBackupService()
+	testBooks := exportTestBooks()
+	testBooks[0].Reading = true
+	// Wishlist books go on the to-read shelf
+	testBooks = append(testBooks, models.Book{ID: 3, Title: "Dune", Author: "Frank Herbert", Type: models.Paperback})
+
+	exportPath := filepath.Join(tempDir, "books-goodreads.csv")
+	if err := service.ExportToGoodreadsCSV(testBooks, exportPath); err != nil {
+		t.Fatalf("ExportToGoodreadsCSV failed: %v", err)
+	}
+
+	file, err := os.Open(exportPath)
+	if err != nil {
+		t.Fatalf("Failed to open exported file: %v", err)
+	}
+	defer file.Close()
+
+	records, err := csv.NewReader(file).ReadAll()
+	if err != nil {
+		t.Fatalf("Exported file is not valid CSV: %v", err)
+	}
+	if len(records) != len(testBooks)+1 {
+		t.Fatalf("Expected %d records including header, got %d", len(testBooks)+1, len(records))
+	}
+
+	if got := strings.Join(records[0], ","); got != "Title,Author,ISBN,My Rating,Date Read,Bookshelves" {
+		t.Errorf("Header = %q, want Goodreads column names", got)
+	}
+
+	for i, book := range testBooks {
+		row := records[i+1]
+		shelf := ""
+		if book.Reading {
+			shelf = "currently-reading"
+		} else if !book.Owned {
+			shelf = "to-read"
+		}
+		want := []string{book.Title, book.Author, "", "", "", shelf}
+		if strings.Join(row, "|") != strings.Join(want, "|") {
+			t.Errorf("Row %d = %q, want %q", i+1, row, want)
+		}
+	}
+}
+
+// TestBackupService_ExportToMarkdownWithTOC tests the Markdown export with a table of contents
+// Each contents entry must link to the anchor of the matching book heading
+func TestBackupService_ExportToMarkdownWithTOC(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "libros_test_md_toc")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	service := services.NewBackupService()
+	testBooks := exportTestBooks()
+	// A repeated title and punctuation exercise slug de-duplication and cleanup
+	testBooks = append(testBooks, testBooks[1])
+	testBooks[0].Title = "The Go Programming Language: 2nd Ed."
+
+	exportPath := filepath.Join(tempDir, "books-contents.md")
+	if err := service.ExportToMarkdownWithTOC(testBooks, exportPath); err != nil {
+		t.Fatalf("ExportToMarkdownWithTOC failed: %v", err)
+	}
+
+	content, err := os.ReadFile(exportPath)
+	if err != nil {
+		t.Fatalf("Failed to read exported file: %v", err)
+	}
+	contentStr := string(content)
+
+	expectedEntries := []string{
+		"- [The Go Programming Language: 2nd Ed.](#the-go-programming-language-2nd-ed)",
+		"- [Clean Code](#clean-code)",
+		"- [Clean Code](#clean-code-1)",
+	}
+	for _, entry := range expectedEntries {
+		if !strings.Contains(contentStr, entry) {
+			t.Errorf("Contents should include %q", entry)
+		}
+	}
+
+	// The contents come before the first book, and headings are plain titles
+	if strings.Index(contentStr, "## Contents") > strings.Index(contentStr, "## The Go Programming Language: 2nd Ed.") {
+		t.Error("Contents should appear before the book sections")
+	}
+	if strings.Count(contentStr, "## Clean Code\n") != 2 {
+		t.Error("Each book should have an unnumbered title heading")
+	}
+
+	// The plain export keeps numbered headings and no contents
+	plainPath := filepath.Join(tempDir, "books.md")
+	if err := service.ExportToMarkdown(testBooks, plainPath); err != nil {
+		t.Fatalf("ExportToMarkdown failed: %v", err)
+	}
+	plain, err := os.ReadFile(plainPath)
+	if err != nil {
+		t.Fatalf("Failed to read exported file: %v", err)
+	}
+	if strings.Contains(string(plain), "## Contents") || !strings.Contains(string(plain), "## 2. Clean Code") {
+		t.Error("Plain Markdown export should be unchanged")
+	}
+}
+
+// TestBackupService_WriteChecksum tests that the checksum manifest matches the file
+// and uses the sha256sum format
+func TestBackupService_WriteChecksum(t *testing.T) {
+	tempDir := t.TempDir()
+	service := services.NewBackupService()
+
+	exportPath := filepath.Join(tempDir, "books.json")
+	if err := service.ExportToJSON(exportTestBooks(), exportPath); err != nil {
+		t.Fatalf("ExportToJSON failed: %v", err)
+	}
+
+	manifestPath, err := service.WriteChecksum(exportPath)
+	if err != nil {
+		t.Fatalf("WriteChecksum failed: %v", err)
+	}
+	if manifestPath != exportPath+".sha256" {
+		t.Errorf("WriteChecksum() path = %q, want %q", manifestPath, exportPath+".sha256")
+	}
+
+	content, err := os.ReadFile(exportPath)
+	if err != nil {
+		t.Fatalf("Failed to read exported file: %v", err)
+	}
+	manifest, err := os.ReadFile(manifestPath)
+	if err != nil {
+		t.Fatalf("Failed to read checksum file: %v", err)
+	}
+	want := fmt.Sprintf("%x  books.json\n", sha256.Sum256(content))
+	if string(manifest) != want {
+		t.Errorf("Checksum file = %q, want %q", manifest, want)
+	}
+
+	// A missing file is reported rather than producing a manifest
+	if _, err := service.WriteChecksum(filepath.Join(tempDir, "missing.json")); err == nil {
+		t.Error("WriteChecksum() on a missing file should return an error")
+	}
+}
+
+// TestBackupService_ExportPerBook tests writing one Markdown file per book
+// Titles that share a slug keep the first book's name and add the ID for the rest
+func TestBackupService_ExportPerBook(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "books")
+	service := services.NewBackupService()
+
+	testBooks := exportTestBooks()
+	books := append(testBooks,
+		models.Book{ID: 7, Title: "The Go Programming Language!", Author: "Someone Else", Type: models.Digital, Owned: true},
+		models.Book{ID: 8, Title: "???", Author: "Anonymous", Type: models.Paperback, Owned: true},
+	)
+
+	if err := service.ExportPerBook(books, dir); err != nil {
+		t.Fatalf("ExportPerBook failed: %v", err)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("Failed to read export directory: %v", err)
+	}
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	want := []string{"book.md", "clean-code.md", "the-go-programming-language-7.md", "the-go-programming-language.md"}
+	if strings.Join(names, ",") != strings.Join(want, ",") {
+		t.Errorf("Exported files = %v, want %v", names, want)
+	}
+
+	content, err := os.ReadFile(filepath.Join(dir, "the-go-programming-language.md"))
+	if err != nil {
+		t.Fatalf("Failed to read exported file: %v", err)
+	}
+	if !strings.HasPrefix(string(content), "# The Go Programming Language\n\n**Author:** "+testBooks[0].Author) {
+		t.Errorf("Book page should open with the title and author, got %q", content)
+	}
+	if !strings.Contains(string(content), testBooks[0].Notes) {
+		t.Error("Book page should include the notes")
+	}
+}
+
+// TestBackupService_VerifyJSONExport tests checking a JSON export's declared count against its books
+// Full and field-limited exports verify, while edited, truncated and foreign files are caught
+func TestBackupService_VerifyJSONExport(t *testing.T) {
+	tempDir := t.TempDir()
+	service := services.NewBackupService()
+	testBooks := exportTestBooks()
+
+	fullPath := filepath.Join(tempDir, "books.json")
+	if err := service.ExportToJSON(testBooks, fullPath); err != nil {
+		t.Fatalf("ExportToJSON failed: %v", err)
+	}
+	fieldsPath := filepath.Join(tempDir, "books-fields.json")
+	if err := service.ExportToJSONFields(testBooks, []string{services.FieldAuthor}, fieldsPath); err != nil {
+		t.Fatalf("ExportToJSONFields failed: %v", err)
+	}
+	for _, path := range []string{fullPath, fieldsPath} {
+		result, err := service.VerifyJSONExport(path)
+		if err != nil {
+			t.Fatalf("VerifyJSONExport(%s) returned error: %v", filepath.Base(path), err)
+		}
+		if !result.Complete() || result.BooksFound != len(testBooks) {
+			t.Errorf("VerifyJSONExport(%s) = %+v, want %d books, complete", filepath.Base(path), result, len(testBooks))
+		}
+	}
+
+	// A book removed by hand leaves the declared count too high
+	content, err := os.ReadFile(fullPath)
+	if err != nil {
+		t.Fatalf("Failed to read exported file: %v", err)
+	}
+	var edited services.BackupData
+	if err := json.Unmarshal(content, &edited); err != nil {
+		t.Fatalf("Failed to parse exported file: %v", err)
+	}
+	edited.Books = edited.Books[1:]
+	editedData, _ := json.Marshal(edited)
+	editedPath := filepath.Join(tempDir, "edited.json")
+	if err := os.WriteFile(editedPath, editedData, 0644); err != nil {
+		t.Fatalf("Failed to write edited file: %v", err)
+	}
+	result, err := service.VerifyJSONExport(editedPath)
+	if err != nil {
+		t.Fatalf("VerifyJSONExport(edited) returned error: %v", err)
+	}
+	if result.Complete() || result.TotalBooks != len(testBooks) || result.BooksFound != len(testBooks)-1 {
+		t.Errorf("VerifyJSONExport(edited) = %+v, want an incomplete export", result)
+	}
+
+	// Truncated, foreign, encrypted and missing files are errors
+	truncatedPath := filepath.Join(tempDir, "truncated.json")
+	os.WriteFile(truncatedPath, content[:len(content)/2], 0644)
+	foreignPath := filepath.Join(tempDir, "foreign.json")
+	os.WriteFile(foreignPath, []byte(`{"name": "not an export"}`), 0644)
+	encryptedPath := filepath.Join(tempDir, "books.json"+services.EncryptedExt)
+	if err := service.ExportEncryptedJSON(testBooks, encryptedPath, "secret"); err != nil {
+		t.Fatalf("ExportEncryptedJSON failed: %v", err)
+	}
+	for _, path := range []string{truncatedPath, foreignPath, encryptedPath, filepath.Join(tempDir, "missing.json")} {
+		if _, err := service.VerifyJSONExport(path); err == nil {
+			t.Errorf("VerifyJSONExport(%s) should have returned an error", filepath.Base(path))
+		}
+	}
+}
+
+// TestBackupService_EncryptedJSON tests that an encrypted export reads back with its passphrase
+// The file must not contain the books in plain text, and a wrong passphrase is reported clearly
+func TestBackupService_EncryptedJSON(t *testing.T) {
+	tempDir := t.TempDir()
+	service := services.NewBackupService()
+	testBooks := exportTestBooks()
+
+	exportPath := filepath.Join(tempDir, "books.json"+services.EncryptedExt)
+	if err := service.ExportEncryptedJSON(testBooks, exportPath, "correct horse"); err != nil {
+		t.Fatalf("ExportEncryptedJSON failed: %v", err)
+	}
+
+	content, err := os.ReadFile(exportPath)
+	if err != nil {
+		t.Fatalf("Failed to read exported file: %v", err)
+	}
+	if strings.Contains(string(content), testBooks[0].Title) {
+		t.Error("Encrypted export should not contain book titles in plain text")
+	}
+
+	books, err := service.ImportEncryptedJSON(exportPath, "correct horse")
+	if err != nil {
+		t.Fatalf("ImportEncryptedJSON failed: %v", err)
+	}
+	if len(books) != len(testBooks) {
+		t.Fatalf("ImportEncryptedJSON() returned %d books, want %d", len(books), len(testBooks))
+	}
+	for i, book := range books {
+		if book.Title != testBooks[i].Title || book.Author != testBooks[i].Author || book.Notes != testBooks[i].Notes || book.Type != testBooks[i].Type {
+			t.Errorf("Book %d = %+v, want %+v", i, book, testBooks[i])
+		}
+	}
+
+	// A wrong passphrase is an error, not a panic or garbled books
+	if _, err := service.ImportEncryptedJSON(exportPath, "wrong horse"); err == nil || err.Error() != "incorrect passphrase" {
+		t.Errorf("ImportEncryptedJSON() with a wrong passphrase error = %v, want incorrect passphrase", err)
+	}
+
+	// A plain JSON export is not mistaken for an encrypted one
+	plainPath := filepath.Join(tempDir, "books.json")
+	if err := service.ExportToJSON(testBooks, plainPath); err != nil {
+		t.Fatalf("ExportToJSON failed: %v", err)
+	}
+	if _, err := service.ImportEncryptedJSON(plainPath, "correct horse"); err == nil {
+		t.Error("ImportEncryptedJSON() on a plain JSON export should return an error")
+	}
+
+	// An empty passphrase is refused
+	if err := service.ExportEncryptedJSON(testBooks, exportPath, ""); err == nil {
+		t.Error("ExportEncryptedJSON() with an empty passphrase should return an error")
+	}
+}
+
+// TestBackupService_AppendManifest tests that each export adds one readable line to
+// the manifest, creating it when missing, and that concurrent appends keep whole lines
+func TestBackupService_AppendManifest(t *testing.T) {
+	manifestPath := filepath.Join(t.TempDir(), "exports", services.ManifestName)
+	service := services.NewBackupService()
+
+	at := time.Date(2024, 5, 1, 10, 4, 5, 0, time.UTC)
+	entry := models.ManifestEntry{Time: at, Format: "json", Path: "/exports/books.json", Books: 12}
+	if err := service.AppendManifest(manifestPath, entry); err != nil {
+		t.Fatalf("AppendManifest failed: %v", err)
+	}
+
+	const writers = 20
+	var wg sync.WaitGroup
+	for i := 0; i < writers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			entry := models.ManifestEntry{Time: at, Format: "markdown", Path: fmt.Sprintf("/exports/books-%d.md", i), Books: i}
+			if err := service.AppendManifest(manifestPath, entry); err != nil {
+				t.Errorf("AppendManifest failed: %v", err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	content, err := os.ReadFile(manifestPath)
+	if err != nil {
+		t.Fatalf("Failed to read manifest: %v", err)
+	}
+	lines := strings.Split(strings.TrimSuffix(string(content), "\n"), "\n")
+	if len(lines) != writers+1 {
+		t.Fatalf("Manifest has %d lines, want %d", len(lines), writers+1)
+	}
+	if want := "2024-05-01T10:04:05Z\tjson\t12 books\t/exports/books.json"; lines[0] != want {
+		t.Errorf("First manifest line = %q, want %q", lines[0], want)
+	}
+	for _, line := range lines[1:] {
+		if fields := strings.Split(line, "\t"); len(fields) != 4 || fields[1] != "markdown" {
+			t.Errorf("Malformed manifest line %q", line)
+		}
+	}
+}
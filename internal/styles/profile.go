@@ -0,0 +1,53 @@
+package styles
+
+import (
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+	"github.com/papadavis47/libros/internal/config"
+)
+
+// Color profile handling
+// Lip Gloss detects the terminal's color support through termenv and renders the
+// theme colors as the nearest color the terminal can show. Without any color support
+// the selection would not be visible, so selected items are marked with text instead
+
+// SelectionMarker is shown before the selected item when the terminal has no colors
+const SelectionMarker = "> "
+
+// ProfileFor returns the color profile to render with for a color_profile setting
+// The auto setting, and any unknown setting, uses the detected profile
+func ProfileFor(setting string, detected termenv.Profile) termenv.Profile {
+	switch setting {
+	case config.ColorProfileTrueColor:
+		return termenv.TrueColor
+	case config.ColorProfile256:
+		return termenv.ANSI256
+	case config.ColorProfile16:
+		return termenv.ANSI
+	case config.ColorProfileNone:
+		return termenv.Ascii
+	}
+	return detected
+}
+
+// ApplyColorProfile sets the color profile all styles render with from the configuration
+// It should be called once at startup, before the first screen is drawn
+func ApplyColorProfile() {
+	lipgloss.SetColorProfile(ProfileFor(config.GetColorProfile(), lipgloss.ColorProfile()))
+}
+
+// Monochrome reports whether styles render without colors or text attributes
+func Monochrome() bool {
+	return lipgloss.ColorProfile() == termenv.Ascii
+}
+
+// markSelected prefixes the selection marker to a selected style's text on a terminal
+// without colors, where its highlight would otherwise not show
+func markSelected(style lipgloss.Style) lipgloss.Style {
+	if !Monochrome() {
+		return style
+	}
+	return style.Transform(func(s string) string {
+		return SelectionMarker + s
+	})
+}
@@ -0,0 +1,54 @@
+package styles
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+	"github.com/papadavis47/libros/internal/config"
+)
+
+// TestProfileFor tests mapping the color_profile setting to a termenv profile
+func TestProfileFor(t *testing.T) {
+	tests := []struct {
+		name     string
+		setting  string
+		detected termenv.Profile
+		expected termenv.Profile
+	}{
+		{"auto uses detected", config.ColorProfileAuto, termenv.ANSI256, termenv.ANSI256},
+		{"auto keeps no color", config.ColorProfileAuto, termenv.Ascii, termenv.Ascii},
+		{"truecolor forced", config.ColorProfileTrueColor, termenv.Ascii, termenv.TrueColor},
+		{"256 forced", config.ColorProfile256, termenv.TrueColor, termenv.ANSI256},
+		{"16 forced", config.ColorProfile16, termenv.TrueColor, termenv.ANSI},
+		{"none forced", config.ColorProfileNone, termenv.TrueColor, termenv.Ascii},
+		{"unknown uses detected", "8", termenv.ANSI, termenv.ANSI},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ProfileFor(tt.setting, tt.detected); got != tt.expected {
+				t.Errorf("ProfileFor(%q, %v) = %v, want %v", tt.setting, tt.detected, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestMarkSelected tests that the selection is marked with text only without colors
+func TestMarkSelected(t *testing.T) {
+	previous := lipgloss.ColorProfile()
+	defer lipgloss.SetColorProfile(previous)
+
+	style := lipgloss.NewStyle().Bold(true)
+
+	lipgloss.SetColorProfile(termenv.Ascii)
+	if got := markSelected(style).Render("Add"); got != SelectionMarker+"Add" {
+		t.Errorf("Without colors, got %q, want %q", got, SelectionMarker+"Add")
+	}
+
+	lipgloss.SetColorProfile(termenv.ANSI)
+	if got := markSelected(style).Render("Add"); strings.Contains(got, SelectionMarker) {
+		t.Errorf("With colors, got %q, want no selection marker", got)
+	}
+}
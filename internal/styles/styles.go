@@ -8,6 +8,7 @@ import (
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/papadavis47/libros/internal/config"
+	"github.com/papadavis47/libros/internal/models"
 	"github.com/papadavis47/libros/internal/utils"
 )
 
@@ -173,8 +174,9 @@ var (
 
 // AddLetterSpacing converts text to have 1.5x letter spacing by adding spaces between characters
 // Example: "Book Title" becomes "B o o k  T i t l e"
+// The text is left as is when full_width is turned off, for easier reading
 func AddLetterSpacing(text string) string {
-	if text == "" {
+	if text == "" || !config.Cached().FullWidth {
 		return text
 	}
 
@@ -197,6 +199,25 @@ func AddLetterSpacing(text string) string {
 	return result.String()
 }
 
+// Label returns a full-width title or menu label for display, converted to plain
+// ASCII when full_width is turned off. Screens still match on the full-width text,
+// so only what is shown changes
+// Example: "Ａｄｄ　Ｂｏｏｋ" becomes "Add Book"
+func Label(text string) string {
+	if config.Cached().FullWidth {
+		return text
+	}
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r == '\u3000': // Ideographic space
+			return ' '
+		case r >= '！' && r <= '～': // Full-width forms of printable ASCII
+			return r - 0xFEE0
+		}
+		return r
+	}, text)
+}
+
 // CapitalizeBookType converts BookType enum values to capitalized display names
 // Example: "paperback" becomes "Paperback", "audio" becomes "Audio"
 // Deprecated: Use utils.FormatBookType instead
@@ -223,6 +244,61 @@ func CreateBookDottedSeparator(width int, style lipgloss.Style) string {
 	return style.Render(separator)
 }
 
+// Book type colors used to tell formats apart at a glance in the book list
+// They are mid-brightness so they stay readable on dark terminals and inside the selected card
+var typeColors = map[models.BookType]lipgloss.Color{
+	models.Paperback: lipgloss.Color("#E5C07B"), // Warm yellow
+	models.Hardback:  lipgloss.Color("#E06C75"), // Soft red
+	models.Audio:     lipgloss.Color("#98C379"), // Green
+	models.Digital:   lipgloss.Color("#61AFEF"), // Blue
+}
+
+// neutralTypeColor is used for book types without a color of their own
+const neutralTypeColor = lipgloss.Color("#B0B0B0")
+
+// TypeColor returns the display color for a book type
+// The High Contrast theme keeps every type in its bright tertiary color, since
+// the pastel type colors would undercut its contrast; unknown types are neutral gray
+func TypeColor(bookType models.BookType) lipgloss.Color {
+	theme := config.GetCurrentTheme()
+	if theme.Name == config.HighContrastTheme.Name {
+		return lipgloss.Color(theme.TertiaryColor)
+	}
+	if color, ok := typeColors[bookType]; ok {
+		return color
+	}
+	return neutralTypeColor
+}
+
+// typeIcons are the glyphs shown before each book type's label
+var typeIcons = map[models.BookType]string{
+	models.Paperback: "📖",
+	models.Hardback:  "📚",
+	models.Audio:     "🎧",
+	models.Digital:   "💻",
+}
+
+// neutralTypeIcon is shown for book types without an icon of their own
+const neutralTypeIcon = "📄"
+
+// TypeIcon returns the glyph for a book type, or a neutral glyph for unknown types
+func TypeIcon(bookType models.BookType) string {
+	if icon, ok := typeIcons[bookType]; ok {
+		return icon
+	}
+	return neutralTypeIcon
+}
+
+// TypeLabel returns a book type's letter-spaced display name, preceded by its icon
+// unless type_icons is turned off for terminals without emoji support
+func TypeLabel(bookType models.BookType) string {
+	label := AddLetterSpacing(CapitalizeBookType(string(bookType)))
+	if !config.Cached().TypeIcons {
+		return label
+	}
+	return TypeIcon(bookType) + " " + label
+}
+
 // Theme-aware style functions
 // These functions return styles based on the current theme configuration
 
@@ -237,13 +313,13 @@ func GetFocusedStyle() lipgloss.Style {
 // GetSelectedStyle returns the themed selected style
 func GetSelectedStyle() lipgloss.Style {
 	theme := config.GetCurrentTheme()
-	return lipgloss.NewStyle().
+	return markSelected(lipgloss.NewStyle().
 		Bold(true).
-		Foreground(lipgloss.Color("#FFFFFF")).
+		Foreground(lipgloss.Color(theme.SelectedText())).
 		Background(lipgloss.Color(theme.PrimaryColor)).
 		Padding(0, 1).
 		MarginLeft(2).
-		PaddingLeft(1)
+		PaddingLeft(1))
 }
 
 // GetFormFocusedStyle returns the themed form focused style
@@ -273,13 +349,13 @@ func GetBoldFocusedStyle() lipgloss.Style {
 // GetBookTitleSelectedStyle returns the themed book title selected style
 func GetBookTitleSelectedStyle() lipgloss.Style {
 	theme := config.GetCurrentTheme()
-	return lipgloss.NewStyle().
+	return markSelected(lipgloss.NewStyle().
 		Bold(true).
-		Foreground(lipgloss.Color("#FFFFFF")).
+		Foreground(lipgloss.Color(theme.SelectedText())).
 		Background(lipgloss.Color(theme.PrimaryColor)).
 		Padding(0, 1).
 		MarginLeft(2).
-		PaddingLeft(1)
+		PaddingLeft(1))
 }
 
 // GetBookTitleUnselectedStyle returns the themed book title unselected style
@@ -315,10 +391,10 @@ func GetBookSeparatorBoldStyle() lipgloss.Style {
 // GetButtonStyle returns the themed button style
 func GetButtonStyle() lipgloss.Style {
 	theme := config.GetCurrentTheme()
-	return lipgloss.NewStyle().
+	return markSelected(lipgloss.NewStyle().
 		Bold(true).
 		Foreground(lipgloss.Color(theme.SecondaryColor)).
-		PaddingLeft(3)
+		PaddingLeft(3))
 }
 
 // GetBookAuthorUnselectedStyle returns the themed book author unselected style
@@ -343,10 +419,10 @@ func GetBookAuthorSelectedStyle() lipgloss.Style {
 // GetBookTypeSelectedStyle returns the themed book type selected style
 func GetBookTypeSelectedStyle() lipgloss.Style {
 	theme := config.GetCurrentTheme()
-	return lipgloss.NewStyle().
+	return markSelected(lipgloss.NewStyle().
 		Bold(true).
 		Foreground(lipgloss.Color(theme.TertiaryColor)).
 		Padding(0, 1).
-		PaddingLeft(3)
+		PaddingLeft(3))
 }
 
@@ -4,10 +4,18 @@
 package ui
 
 import (
+	"fmt"
+
 	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/papadavis47/libros/internal/config"
+	"github.com/papadavis47/libros/internal/constants"
 	"github.com/papadavis47/libros/internal/database"
+	"github.com/papadavis47/libros/internal/messages"
 	"github.com/papadavis47/libros/internal/models"
+	"github.com/papadavis47/libros/internal/styles"
 	"github.com/papadavis47/libros/internal/ui/screens"
 )
 
@@ -16,6 +24,10 @@ import (
 type Model struct {
 	db            *database.DB    // Database connection shared across all screens
 	currentScreen models.Screen   // Current active screen being displayed
+	width         int             // Terminal width, reapplied after switching libraries
+	height        int             // Terminal height, reapplied after switching libraries
+	body          viewport.Model  // Scroll position of a long screen's body below its pinned header
+	editReturn    models.Screen   // Screen the edit form goes back to: the book's details or the attention report
 	
 	// Screen models - each screen has its own model that handles specific functionality
 	menu      screens.MenuModel       // Main menu screen model
@@ -27,6 +39,21 @@ type Model struct {
 	theme     screens.ThemeModel      // Theme selection screen model
 	exportScreen *screens.ExportScreen // Export data screen model
 	backup    *screens.BackupScreen   // Backup data screen model
+	integrity *screens.IntegrityScreen // Database integrity check screen model
+	validate  *screens.ValidateScreen  // Collection validation screen model
+	stats     *screens.StatsScreen     // Collection statistics screen model
+	duplicates *screens.DuplicatesScreen // Duplicate finding and merging screen model
+	dbInfo    *screens.DBInfoScreen    // Database info screen model
+	importScreen *screens.ImportScreen // Title list import screen model
+	bulkAdd   *screens.BulkAddScreen   // Bulk add from pasted lines screen model
+	verify    *screens.VerifyExportScreen // Export verification screen model
+	normalize *screens.NormalizeScreen // Empty title and author fix screen model
+	attention *screens.AttentionScreen // Needs attention report screen model
+	library   *screens.LibraryScreen   // Library switching screen model
+	history   *screens.HistoryScreen   // Book edit history screen model
+	clear     *screens.ClearScreen     // Clear collection screen model
+
+	palette   screens.PaletteModel    // Quick switcher shown over any screen with ctrl+p
 }
 
 // NewModel creates and initializes a new main application model
@@ -36,23 +63,149 @@ func NewModel(db *database.DB) Model {
 	return Model{
 		db:            db,                                // Store database connection
 		currentScreen: models.MenuScreen,                 // Start at main menu
+		editReturn:    models.BookDetailScreen,           // Edits go back to the book's details
 		menu:          screens.NewMenuModel(db),          // Initialize menu screen
 		addBook:       screens.NewAddBookModel(db),       // Initialize add book screen
-		listBooks:     screens.NewListBooksModel(),       // Initialize book list screen
+		listBooks:     screens.NewListBooksModel(db),     // Initialize book list screen
 		detail:        screens.NewDetailModel(db),        // Initialize detail view screen
 		edit:          screens.NewEditModel(db),          // Initialize edit screen
 		utilities:     screens.NewUtilitiesModel(db),     // Initialize utilities screen
 		theme:         screens.NewThemeModel(),           // Initialize theme selection screen
 		exportScreen:  screens.NewExportScreen(db),       // Initialize export screen
 		backup:        screens.NewBackupScreen(db),       // Initialize backup screen
+		integrity:     screens.NewIntegrityScreen(db),    // Initialize integrity check screen
+		validate:      screens.NewValidateScreen(db),     // Initialize collection validation screen
+		stats:         screens.NewStatsScreen(db),        // Initialize statistics screen
+		duplicates:    screens.NewDuplicatesScreen(db),   // Initialize duplicates screen
+		dbInfo:        screens.NewDBInfoScreen(db),       // Initialize database info screen
+		importScreen:  screens.NewImportScreen(db),       // Initialize import screen
+		bulkAdd:       screens.NewBulkAddScreen(db),      // Initialize bulk add screen
+		verify:        screens.NewVerifyExportScreen(db), // Initialize export verification screen
+		normalize:     screens.NewNormalizeScreen(db),    // Initialize empty field fix screen
+		attention:     screens.NewAttentionScreen(db),    // Initialize needs attention report screen
+		library:       screens.NewLibraryScreen(db),      // Initialize library switching screen
+		history:       screens.NewHistoryScreen(db),      // Initialize edit history screen
+		clear:         screens.NewClearScreen(db),        // Initialize clear collection screen
+		palette:       screens.NewPaletteModel(db),       // Initialize quick switcher
 	}
 }
 
 // Init initializes the Bubble Tea model and returns the initial command
 // This is called once when the program starts to set up the initial state
 func (m Model) Init() tea.Cmd {
-	// Return the text input blink command to start cursor blinking
-	return textinput.Blink
+	// Start cursor blinking and reopen the last screen if the session is resumed
+	return tea.Batch(textinput.Blink, m.resumeCmd())
+}
+
+// resumeCmd loads the collection for the screen the last session ended on
+// It returns nil when resuming is turned off or the last session ended on the menu
+func (m Model) resumeCmd() tea.Cmd {
+	if !config.GetResumeSession() {
+		return nil
+	}
+	state, err := config.LoadState()
+	if err != nil || (state.Screen != config.StateScreenList && state.Screen != config.StateScreenDetail) {
+		return nil
+	}
+	// A session in another library refers to books that are not in this one
+	if state.Library != "" && state.Library != m.db.Path() {
+		return nil
+	}
+	bookID := 0
+	if state.Screen == config.StateScreenDetail {
+		bookID = state.BookID
+	}
+	return func() tea.Msg {
+		books, err := m.db.LoadBooks()
+		return messages.ResumeMsg{Books: books, BookID: bookID, Err: err}
+	}
+}
+
+// resume opens the book list, and the remembered book's details if it still exists
+// The menu stays open if the books cannot be loaded, the collection is empty,
+// or the user has already moved on from the menu
+func (m Model) resume(msg messages.ResumeMsg) (tea.Model, tea.Cmd) {
+	if m.currentScreen != models.MenuScreen || msg.Err != nil || len(msg.Books) == 0 {
+		return m, nil
+	}
+
+	// A deleted book leaves the session on the list
+	return m.showBook(msg.Books, msg.BookID)
+}
+
+// showBook loads books into the list and opens the details of the book with bookID
+// The list is left open if bookID is 0 or the book is not among them
+func (m Model) showBook(books []models.Book, bookID int) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+	m.listBooks, cmd, _, _ = m.listBooks.Update(messages.LoadBooksMsg{Books: books})
+	m.listBooks.ClearDeleted()
+	m.currentScreen = models.ListBooksScreen
+
+	listed := m.listBooks.Books()
+	for i, book := range listed {
+		if bookID != 0 && book.ID == bookID {
+			m.listBooks.SetIndex(i)
+			cmd = tea.Batch(cmd, m.detail.SetBooks(listed, i))
+			m.currentScreen = models.BookDetailScreen
+			break
+		}
+	}
+	return m, cmd
+}
+
+// updatePalette passes a key press to the open quick switcher
+// Choosing a book closes the switcher and opens the book's details from the full list
+func (m Model) updatePalette(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+	var bookID int
+	m.palette, cmd, bookID = m.palette.Update(msg)
+	if bookID == 0 {
+		return m, cmd
+	}
+
+	books := m.palette.Books()
+	m.palette.Close()
+	// Clear any list filter so the chosen book is not hidden by it
+	m.listBooks.ResetFilter()
+	m.detail.ClearUpdated()
+	var showCmd tea.Cmd
+	m, showCmd = m.showBook(books, bookID)
+	m.saveSession()
+	return m, tea.Batch(cmd, showCmd)
+}
+
+// saveSession remembers the current screen so the next start can resume it
+// The list and a book's details (including while editing it or viewing its history) are remembered,
+// and every other screen is remembered as the main menu
+func (m Model) saveSession() {
+	if !config.GetResumeSession() {
+		return
+	}
+	state := config.SessionState{Library: m.db.Path()}
+	switch m.currentScreen {
+	case models.ListBooksScreen:
+		state.Screen = config.StateScreenList
+	case models.BookDetailScreen, models.EditBookScreen, models.HistoryScreen:
+		if m.detail.SelectedBook != nil {
+			state.Screen = config.StateScreenDetail
+			state.BookID = m.detail.SelectedBook.ID
+		}
+	}
+	// Failing to save only means the next start opens on the menu
+	_ = config.SaveState(state)
+}
+
+// switchLibrary closes the current database and rebuilds every screen on db,
+// landing on the main menu of the new library
+func (m Model) switchLibrary(db *database.DB) (tea.Model, tea.Cmd) {
+	m.db.Close()
+	width, height := m.width, m.height
+	m = NewModel(db)
+	m.width, m.height = width, height
+	m.detail.SetSize(width, height)
+	m.listBooks.SetSize(width, height)
+	m.saveSession()
+	return m, nil
 }
 
 // Update handles incoming messages and updates the model state
@@ -60,18 +213,61 @@ func (m Model) Init() tea.Cmd {
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	// Handle global key commands that work across all screens
 	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		// Let the detail screen size its notes viewport and the list
+		// screen its book list to the terminal
+		m.width, m.height = msg.Width, msg.Height
+		m.detail.SetSize(msg.Width, msg.Height)
+		m.listBooks.SetSize(msg.Width, msg.Height)
+	case messages.ResumeMsg:
+		// Reopen the screen the last session ended on
+		return m.resume(msg)
+	case messages.LibraryOpenedMsg:
+		// Rebuild every screen on the newly opened library; a failure is
+		// left to the library screen to report
+		if msg.Err == nil {
+			return m.switchLibrary(msg.DB)
+		}
 	case tea.KeyMsg:
 		// Ctrl+C always quits the application immediately
 		if msg.String() == "ctrl+c" {
-			m.db.Close() // Clean up database connection
+			m.saveSession() // Remember where to resume next time
+			if m.currentScreen == models.AddBookScreen {
+				m.addBook.SaveDraft() // Keep the entry so it can be restored next time
+			}
+			m.db.Close()    // Clean up database connection
 			return m, tea.Quit
 		}
-		// 'q' quits the application, but not from input screens (add/edit)
+		// While the quick switcher is open, every other key belongs to it
+		if m.palette.Active() {
+			return m.updatePalette(msg)
+		}
+		// Ctrl+P opens the quick switcher, except on the add and edit forms
+		// where it steps back through the author suggestions
+		if msg.String() == "ctrl+p" && m.currentScreen != models.AddBookScreen && m.currentScreen != models.EditBookScreen {
+			return m, m.palette.Open()
+		}
+		// 'q' quits the application, but not from input screens (add/edit/clear/import/bulk add/verify/normalize),
+		// while typing a book list filter, an export passphrase or a borrower's name
 		// This prevents accidental quits while typing
-		if msg.String() == "q" && m.currentScreen != models.AddBookScreen && m.currentScreen != models.EditBookScreen {
-			m.db.Close() // Clean up database connection
+		typingFilter := m.currentScreen == models.ListBooksScreen && m.listBooks.Filtering()
+		typingPassphrase := m.currentScreen == models.ExportScreen && m.exportScreen.EnteringPassphrase()
+		typingBorrower := m.currentScreen == models.BookDetailScreen && m.detail.EnteringBorrower()
+		if msg.String() == "q" && !typingFilter && !typingPassphrase && !typingBorrower && m.currentScreen != models.AddBookScreen && m.currentScreen != models.EditBookScreen && m.currentScreen != models.ClearScreen && m.currentScreen != models.ImportScreen && m.currentScreen != models.BulkAddScreen && m.currentScreen != models.VerifyExportScreen && m.currentScreen != models.NormalizeScreen {
+			m.saveSession() // Remember where to resume next time
+			m.db.Close()    // Clean up database connection
 			return m, tea.Quit
 		}
+		// PgUp/PgDn scroll a long screen's body beneath its pinned header
+		if scrolled, ok := m.scrollBody(msg); ok {
+			return scrolled, nil
+		}
+	}
+
+	// The quick switcher also needs its loaded books and cursor blinks
+	var paletteCmd tea.Cmd
+	if m.palette.Active() {
+		m.palette, paletteCmd, _ = m.palette.Update(msg)
 	}
 
 	// Variables to track the command to execute and potential screen changes
@@ -109,9 +305,14 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Update list screen and check if a book was selected
 		m.listBooks, listCmd, newScreen, selectedBook = m.listBooks.Update(msg)
 		cmd = listCmd
-		// If a book was selected, prepare it for the detail screen
+		// If a book was selected, prepare the detail screen with the book and
+		// its position in the list so it can step to the next/previous book
 		if selectedBook != nil {
-			m.detail.SetBook(selectedBook)
+			cmd = tea.Batch(cmd, m.detail.SetBooks(m.listBooks.Books(), m.listBooks.Index()))
+		}
+		// A search with no matches can be added as a new book, titled with the search
+		if newScreen == models.AddBookScreen {
+			m.addBook.SetTitle(m.listBooks.NewTitle())
 		}
 		// Refresh menu when returning (in case books were deleted)
 		if newScreen == models.MenuScreen {
@@ -126,6 +327,11 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// If transitioning to edit screen, pass the current book data
 		if newScreen == models.EditBookScreen {
 			m.edit.SetBook(m.detail.SelectedBook)
+			m.editReturn = models.BookDetailScreen
+		}
+		// Keep the list selection on the last book viewed
+		if newScreen == models.ListBooksScreen {
+			m.listBooks.SetIndex(m.detail.Position())
 		}
 		
 	case models.EditBookScreen:
@@ -137,6 +343,13 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if newScreen == models.BookDetailScreen {
 			m.detail.ClearUpdated()
 		}
+		// A book opened from the attention report goes back to the report,
+		// which checks the collection again to reflect the edit
+		if newScreen == models.BookDetailScreen && m.editReturn == models.AttentionScreen {
+			newScreen = models.AttentionScreen
+			m.editReturn = models.BookDetailScreen
+			cmd = tea.Batch(cmd, m.attention.Refresh())
+		}
 		
 	case models.UtilitiesScreen:
 		// Utilities only handles key messages
@@ -145,6 +358,10 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			// Update utilities model and get any screen transition
 			m.utilities, utilitiesCmd, newScreen = m.utilities.Update(keyMsg)
 			cmd = utilitiesCmd
+			// Check the collection afresh each time the attention report is opened
+			if newScreen == models.AttentionScreen {
+				cmd = tea.Batch(cmd, m.attention.Start())
+			}
 		} else {
 			// No screen change if message isn't a key press
 			newScreen = m.currentScreen
@@ -195,13 +412,227 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		} else {
 			newScreen = m.currentScreen
 		}
+
+	case models.IntegrityScreen:
+		var integrityModel tea.Model
+		var integrityCmd tea.Cmd
+		// Update integrity check screen model
+		integrityModel, integrityCmd = m.integrity.Update(msg)
+		m.integrity = integrityModel.(*screens.IntegrityScreen)
+		cmd = integrityCmd
+		// Handle screen transitions from integrity check screen
+		if switchMsg, ok := msg.(screens.SwitchScreenMsg); ok {
+			newScreen = switchMsg.Screen
+		} else {
+			newScreen = m.currentScreen
+		}
+
+	case models.ValidateScreen:
+		var validateModel tea.Model
+		var validateCmd tea.Cmd
+		// Update collection validation screen model
+		validateModel, validateCmd = m.validate.Update(msg)
+		m.validate = validateModel.(*screens.ValidateScreen)
+		cmd = validateCmd
+		// Handle screen transitions from collection validation screen
+		if switchMsg, ok := msg.(screens.SwitchScreenMsg); ok {
+			newScreen = switchMsg.Screen
+		} else {
+			newScreen = m.currentScreen
+		}
+
+	case models.StatsScreen:
+		var statsModel tea.Model
+		var statsCmd tea.Cmd
+		// Update statistics screen model
+		statsModel, statsCmd = m.stats.Update(msg)
+		m.stats = statsModel.(*screens.StatsScreen)
+		cmd = statsCmd
+		// Handle screen transitions from statistics screen
+		if switchMsg, ok := msg.(screens.SwitchScreenMsg); ok {
+			newScreen = switchMsg.Screen
+		} else {
+			newScreen = m.currentScreen
+		}
+
+	case models.DuplicatesScreen:
+		var duplicatesModel tea.Model
+		var duplicatesCmd tea.Cmd
+		// Update duplicates screen model
+		duplicatesModel, duplicatesCmd = m.duplicates.Update(msg)
+		m.duplicates = duplicatesModel.(*screens.DuplicatesScreen)
+		cmd = duplicatesCmd
+		// Handle screen transitions from duplicates screen
+		if switchMsg, ok := msg.(screens.SwitchScreenMsg); ok {
+			newScreen = switchMsg.Screen
+		} else {
+			newScreen = m.currentScreen
+		}
+
+	case models.DBInfoScreen:
+		var dbInfoModel tea.Model
+		var dbInfoCmd tea.Cmd
+		// Update database info screen model
+		dbInfoModel, dbInfoCmd = m.dbInfo.Update(msg)
+		m.dbInfo = dbInfoModel.(*screens.DBInfoScreen)
+		cmd = dbInfoCmd
+		// Handle screen transitions from database info screen
+		if switchMsg, ok := msg.(screens.SwitchScreenMsg); ok {
+			newScreen = switchMsg.Screen
+		} else {
+			newScreen = m.currentScreen
+		}
+
+	case models.ImportScreen:
+		var importModel tea.Model
+		var importCmd tea.Cmd
+		// Update import screen model
+		importModel, importCmd = m.importScreen.Update(msg)
+		m.importScreen = importModel.(*screens.ImportScreen)
+		cmd = importCmd
+		// Handle screen transitions from import screen
+		if switchMsg, ok := msg.(screens.SwitchScreenMsg); ok {
+			newScreen = switchMsg.Screen
+		} else {
+			newScreen = m.currentScreen
+		}
+		// Refresh menu counts in case books were imported
+		if newScreen != m.currentScreen {
+			m.menu.RefreshItems()
+		}
+
+	case models.BulkAddScreen:
+		var bulkAddModel tea.Model
+		var bulkAddCmd tea.Cmd
+		// Update bulk add screen model
+		bulkAddModel, bulkAddCmd = m.bulkAdd.Update(msg)
+		m.bulkAdd = bulkAddModel.(*screens.BulkAddScreen)
+		cmd = bulkAddCmd
+		// Handle screen transitions from bulk add screen
+		if switchMsg, ok := msg.(screens.SwitchScreenMsg); ok {
+			newScreen = switchMsg.Screen
+		} else {
+			newScreen = m.currentScreen
+		}
+		// Refresh menu counts in case books were added
+		if newScreen != m.currentScreen {
+			m.menu.RefreshItems()
+		}
+
+	case models.VerifyExportScreen:
+		var verifyModel tea.Model
+		var verifyCmd tea.Cmd
+		// Update export verification screen model
+		verifyModel, verifyCmd = m.verify.Update(msg)
+		m.verify = verifyModel.(*screens.VerifyExportScreen)
+		cmd = verifyCmd
+		// Handle screen transitions from export verification screen
+		if switchMsg, ok := msg.(screens.SwitchScreenMsg); ok {
+			newScreen = switchMsg.Screen
+		} else {
+			newScreen = m.currentScreen
+		}
+
+	case models.NormalizeScreen:
+		var normalizeModel tea.Model
+		var normalizeCmd tea.Cmd
+		// Update empty field fix screen model
+		normalizeModel, normalizeCmd = m.normalize.Update(msg)
+		m.normalize = normalizeModel.(*screens.NormalizeScreen)
+		cmd = normalizeCmd
+		// Handle screen transitions from empty field fix screen
+		if switchMsg, ok := msg.(screens.SwitchScreenMsg); ok {
+			newScreen = switchMsg.Screen
+		} else {
+			newScreen = m.currentScreen
+		}
+
+	case models.AttentionScreen:
+		var attentionModel tea.Model
+		var attentionCmd tea.Cmd
+		// Update needs attention report screen model
+		attentionModel, attentionCmd = m.attention.Update(msg)
+		m.attention = attentionModel.(*screens.AttentionScreen)
+		cmd = attentionCmd
+		// Handle screen transitions from needs attention report screen
+		if switchMsg, ok := msg.(screens.SwitchScreenMsg); ok {
+			newScreen = switchMsg.Screen
+		} else {
+			newScreen = m.currentScreen
+		}
+		// Open the chosen book on the edit screen, coming back here afterwards
+		if newScreen == models.EditBookScreen {
+			m.edit.SetBook(m.attention.SelectedBook())
+			m.editReturn = models.AttentionScreen
+		}
+
+	case models.HistoryScreen:
+		var historyModel tea.Model
+		var historyCmd tea.Cmd
+		// Update edit history screen model
+		historyModel, historyCmd = m.history.Update(msg)
+		m.history = historyModel.(*screens.HistoryScreen)
+		cmd = historyCmd
+		// Handle screen transitions from edit history screen
+		if switchMsg, ok := msg.(screens.SwitchScreenMsg); ok {
+			newScreen = switchMsg.Screen
+		} else {
+			newScreen = m.currentScreen
+		}
+
+	case models.LibraryScreen:
+		var libraryModel tea.Model
+		var libraryCmd tea.Cmd
+		// Update library switching screen model
+		libraryModel, libraryCmd = m.library.Update(msg)
+		m.library = libraryModel.(*screens.LibraryScreen)
+		cmd = libraryCmd
+		// Handle screen transitions from library switching screen
+		if switchMsg, ok := msg.(screens.SwitchScreenMsg); ok {
+			newScreen = switchMsg.Screen
+		} else {
+			newScreen = m.currentScreen
+		}
+
+	case models.ClearScreen:
+		var clearModel tea.Model
+		var clearCmd tea.Cmd
+		// Update clear collection screen model
+		clearModel, clearCmd = m.clear.Update(msg)
+		m.clear = clearModel.(*screens.ClearScreen)
+		cmd = clearCmd
+		// Handle screen transitions from clear collection screen
+		if switchMsg, ok := msg.(screens.SwitchScreenMsg); ok {
+			newScreen = switchMsg.Screen
+		} else {
+			newScreen = m.currentScreen
+		}
+		// Refresh menu counts after the collection has been cleared
+		if newScreen == models.MenuScreen {
+			m.menu.RefreshItems()
+		}
 	}
 
 	// Handle screen transitions and perform any necessary cleanup
 	if newScreen != m.currentScreen {
 		m.currentScreen = newScreen
+		m.body.GotoTop() // Each screen opens scrolled to the top
+
+		// Remember the screen in case the app is closed from the menu's Quit entry
+		if newScreen == models.MenuScreen || newScreen == models.ListBooksScreen || newScreen == models.BookDetailScreen {
+			m.saveSession()
+		}
 		
 		// Perform screen-specific cleanup when transitioning
+		if newScreen == models.AddBookScreen {
+			// Load existing authors once for autocomplete suggestions, and any
+			// draft left by an entry that was never saved
+			cmd = tea.Batch(cmd, m.addBook.LoadAuthorsCmd(), m.addBook.LoadDraftCmd())
+		}
+		if newScreen == models.EditBookScreen {
+			// Load existing authors once for autocomplete suggestions
+			cmd = tea.Batch(cmd, m.edit.LoadAuthorsCmd())
+		}
 		if newScreen == models.ListBooksScreen {
 			// Clear any delete confirmation state when entering list screen
 			m.listBooks.ClearDeleted()
@@ -210,19 +641,81 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			// Clear any export status when entering export screen
 			m.exportScreen.ClearStatus()
 		}
+		if newScreen == models.BackupScreen {
+			// Back up the database each time the screen is opened
+			m.backup.Start()
+		}
 		if newScreen == models.ThemeScreen {
 			// Reset theme screen to reflect current theme
 			m.theme = screens.NewThemeModel()
 		}
+		if newScreen == models.IntegrityScreen {
+			// Run a fresh integrity check each time the screen is opened
+			cmd = tea.Batch(cmd, m.integrity.Start())
+		}
+		if newScreen == models.ValidateScreen {
+			// Validate the current collection each time the screen is opened
+			cmd = tea.Batch(cmd, m.validate.Start())
+		}
+		if newScreen == models.StatsScreen {
+			// Gather fresh statistics each time the screen is opened
+			cmd = tea.Batch(cmd, m.stats.Start())
+		}
+		if newScreen == models.DuplicatesScreen {
+			// Look for duplicates in the current collection each time the screen is opened
+			cmd = tea.Batch(cmd, m.duplicates.Start())
+		}
+		if newScreen == models.DBInfoScreen {
+			// Read the database details afresh each time the screen is opened
+			cmd = tea.Batch(cmd, m.dbInfo.Start())
+		}
+		if newScreen == models.ImportScreen {
+			// Start with an empty, focused file path input
+			cmd = tea.Batch(cmd, m.importScreen.Reset())
+		}
+		if newScreen == models.BulkAddScreen {
+			// Start with an empty, focused entry
+			cmd = tea.Batch(cmd, m.bulkAdd.Reset())
+		}
+		if newScreen == models.VerifyExportScreen {
+			// Start with an empty, focused file path input
+			cmd = tea.Batch(cmd, m.verify.Reset())
+		}
+		if newScreen == models.NormalizeScreen {
+			// Look for incomplete books afresh each time the screen is opened
+			cmd = tea.Batch(cmd, m.normalize.Start())
+		}
+		if newScreen == models.HistoryScreen {
+			// Load the history of the book shown on the detail screen
+			cmd = tea.Batch(cmd, m.history.Start(m.detail.SelectedBook))
+		}
+		if newScreen == models.LibraryScreen {
+			// List the library files afresh each time the screen is opened
+			cmd = tea.Batch(cmd, m.library.Start())
+		}
+		if newScreen == models.ClearScreen {
+			// Start with an empty, focused confirmation input
+			cmd = tea.Batch(cmd, m.clear.Reset())
+		}
 	}
 
 	// Return updated model and any command to execute
-	return m, cmd
+	return m, tea.Batch(cmd, paletteCmd)
 }
 
 // View renders the current screen by delegating to the appropriate screen model
 // It returns the string representation of the UI for the current screen
 func (m Model) View() string {
+	// The quick switcher replaces the screen while it is open
+	if m.palette.Active() {
+		return "\n" + m.palette.View()
+	}
+
+	// Long screens keep their header in place while the body scrolls
+	if screen, ok := m.pinnedScreen(); ok {
+		return "\n" + m.viewPinned(screen)
+	}
+
 	// Add top margin to all screens for better vertical spacing
 	var screenContent string
 	
@@ -246,6 +739,32 @@ func (m Model) View() string {
 		screenContent = m.exportScreen.View() // Render export screen
 	case models.BackupScreen:
 		screenContent = m.backup.View()    // Render backup screen
+	case models.IntegrityScreen:
+		screenContent = m.integrity.View() // Render integrity check screen
+	case models.ValidateScreen:
+		screenContent = m.validate.View()  // Render collection validation screen
+	case models.StatsScreen:
+		screenContent = m.stats.View()     // Render statistics screen
+	case models.DuplicatesScreen:
+		screenContent = m.duplicates.View() // Render duplicates screen
+	case models.DBInfoScreen:
+		screenContent = m.dbInfo.View()    // Render database info screen
+	case models.ImportScreen:
+		screenContent = m.importScreen.View() // Render import screen
+	case models.BulkAddScreen:
+		screenContent = m.bulkAdd.View()   // Render bulk add screen
+	case models.VerifyExportScreen:
+		screenContent = m.verify.View()    // Render export verification screen
+	case models.NormalizeScreen:
+		screenContent = m.normalize.View() // Render empty field fix screen
+	case models.AttentionScreen:
+		screenContent = m.attention.View() // Render needs attention report screen
+	case models.LibraryScreen:
+		screenContent = m.library.View()   // Render library switching screen
+	case models.HistoryScreen:
+		screenContent = m.history.View()   // Render edit history screen
+	case models.ClearScreen:
+		screenContent = m.clear.View()     // Render clear collection screen
 	default:
 		// Fallback for unknown screen states
 		screenContent = ""
@@ -254,3 +773,62 @@ func (m Model) View() string {
 	// Add top margin to move all content down from the top of the terminal
 	return "\n" + screenContent
 }
+
+// pinnedScreen returns the current screen when its header is pinned above a scrolling body.
+// Only screens that can grow taller than the terminal are pinned, and only once
+// the terminal size is known.
+func (m Model) pinnedScreen() (screens.Pinned, bool) {
+	if m.height == 0 || !config.Cached().PinHeader {
+		return nil, false
+	}
+	switch m.currentScreen {
+	case models.BookDetailScreen:
+		return m.detail, true
+	case models.StatsScreen:
+		return m.stats, true
+	}
+	return nil, false
+}
+
+// syncBody sizes the body viewport to the lines left below the header and loads body into it.
+// The top margin and the scroll indicator each take one more line.
+func (m *Model) syncBody(header, body string) {
+	m.body.Width = m.width
+	m.body.Height = max(m.height-lipgloss.Height(header)-1, constants.PinnedBodyMinHeight)
+	m.body.SetContent(body)
+}
+
+// scrollBody scrolls the current screen's body for PgUp and PgDn.
+// It reports false, leaving the key to the screen, when the screen is not pinned,
+// its body already fits, or the detail screen's long notes have the focus.
+func (m Model) scrollBody(msg tea.KeyMsg) (Model, bool) {
+	screen, ok := m.pinnedScreen()
+	if !ok || (m.currentScreen == models.BookDetailScreen && m.detail.NotesFocused()) {
+		return m, false
+	}
+	m.syncBody(screen.Header(), screen.Body())
+	if m.body.TotalLineCount() <= m.body.Height {
+		return m, false
+	}
+	switch msg.String() {
+	case "pgup":
+		m.body.PageUp()
+	case "pgdown":
+		m.body.PageDown()
+	default:
+		return m, false
+	}
+	return m, true
+}
+
+// viewPinned renders a pinned screen's header followed by as much of its body as fits,
+// with a line showing which part of the body is visible
+func (m Model) viewPinned(screen screens.Pinned) string {
+	header, body := screen.Header(), screen.Body()
+	m.syncBody(header, body)
+	if m.body.TotalLineCount() <= m.body.Height {
+		return header + body
+	}
+	indicator := fmt.Sprintf("Lines %d-%d of %d · PgUp/PgDn to scroll", m.body.YOffset+1, m.body.YOffset+m.body.VisibleLineCount(), m.body.TotalLineCount())
+	return header + m.body.View() + "\n" + styles.BlurredStyle.Render(styles.AddLetterSpacing(indicator))
+}
@@ -1,17 +1,22 @@
 // Package screens contains all the individual screen models for the Libros application
 // This file implements the AddBookModel which handles the "Add New Book" functionality
-// Users can input book title, author, select book type, and add optional notes
+// Users can input book title, author, optional location, select book type, and add optional notes
 package screens
 
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/bubbles/textarea"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/papadavis47/libros/internal/config"
+	"github.com/papadavis47/libros/internal/constants"
 	"github.com/papadavis47/libros/internal/database"
 	"github.com/papadavis47/libros/internal/factory"
+	"github.com/papadavis47/libros/internal/help"
+	"github.com/papadavis47/libros/internal/i18n"
 	"github.com/papadavis47/libros/internal/messages"
 	"github.com/papadavis47/libros/internal/models"
 	"github.com/papadavis47/libros/internal/styles"
@@ -21,13 +26,21 @@ import (
 // It manages form inputs, book type selection, and user interaction
 type AddBookModel struct {
 	db           *database.DB      // Database connection for saving books
-	inputs       []textinput.Model // Text input fields [0]=title, [1]=author
+	inputs       []textinput.Model // Text input fields [0]=title, [1]=author, [2]=location
 	textarea     textarea.Model    // Multi-line text area for optional notes
+	template     string            // Notes template from the config, read when the form is created or reset
 	bookTypes    []models.BookType // Available book types (paperback, hardback, etc.)
 	selectedType int               // Currently selected book type index
 	focused      int               // Index of currently focused UI element
 	err          error             // Error from save operation, if any
 	saved        bool              // Flag indicating if book was successfully saved
+	sessionCount int               // Number of books saved since the screen was last opened
+	lastType     models.BookType   // Type of the last book saved since Libros started, empty before the first
+	reviewing    bool              // Whether the summary is shown, waiting for y to save
+	dismissSeq   int               // Counts saved messages, so only the latest one's timer hides it
+	draft        config.Draft      // Unsaved draft found when the form opened, waiting for y/n
+	restoring    bool              // Whether the restore prompt is shown for draft
+	draftSeq     int               // Counts form changes, so only the latest one's timer saves the draft
 }
 
 // NewAddBookModel creates and initializes a new AddBookModel instance
@@ -36,28 +49,85 @@ type AddBookModel struct {
 func NewAddBookModel(db *database.DB) AddBookModel {
 	m := AddBookModel{
 		db:           db,                                                                                 // Store database connection
-		inputs:       make([]textinput.Model, 2),                                                         // Create title and author inputs
+		inputs:       make([]textinput.Model, 3),                                                         // Create title, author and location inputs
 		bookTypes:    []models.BookType{models.Paperback, models.Hardback, models.Audio, models.Digital}, // All available book types
-		selectedType: 0,                                                                                  // Default to first type (Paperback)
+		selectedType: 0,                                                                                  // Replaced below by the configured default type
 		focused:      0,                                                                                  // Start focus on title field
 	}
 
+	m.selectedType = defaultTypeIndex(m.bookTypes)
+
 	// Initialize text inputs using factory functions
 	m.inputs[0] = factory.CreateTitleInput()
 	m.inputs[1] = factory.CreateAuthorInput()
+	m.inputs[2] = factory.CreateLocationInput()
 
-	// Initialize textarea using factory function
+	// Initialize textarea using factory function, seeded with any notes template
+	m.template = config.GetNotesTemplate()
 	m.textarea = factory.CreateNotesTextArea()
+	m.textarea.SetValue(m.template)
 
 	return m
 }
 
 // Update handles all user input and state changes for the Add Book screen
 // It processes keyboard input, form navigation, book type selection, and form submission
+// Any change to the fields schedules the draft to be saved once typing pauses
 // Returns the updated model, any commands to execute, and potential screen transitions
 func (m AddBookModel) Update(msg tea.Msg) (AddBookModel, tea.Cmd, models.Screen) {
+	before := m.currentDraft()
+	m, cmd, screen := m.update(msg)
+	switch {
+	case m.restoring:
+		// Nothing is written until the user chooses whether to restore the draft
+	case screen != models.AddBookScreen:
+		// The form is reset on leaving, so a pending save would find it empty
+		cmd = tea.Batch(cmd, writeDraftCmd(m.currentDraft()))
+	case m.currentDraft() != before:
+		m.draftSeq++
+		cmd = tea.Batch(cmd, draftSaveCmd(m.draftSeq))
+	}
+	return m, cmd, screen
+}
+
+// update applies msg to the form without scheduling a draft save
+func (m AddBookModel) update(msg tea.Msg) (AddBookModel, tea.Cmd, models.Screen) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		// While the restore prompt is shown, only restore or discard the draft
+		if m.restoring {
+			switch msg.String() {
+			case "y":
+				m.restoring = false
+				m.applyDraft(m.draft)
+			case "n":
+				m.restoring = false
+				m.draft = config.Draft{}
+				return m, writeDraftCmd(config.Draft{}), models.AddBookScreen
+			}
+			return m, nil, models.AddBookScreen
+		}
+
+		// While reviewing, only confirm or go back to editing
+		if m.reviewing {
+			switch msg.String() {
+			case "y":
+				m.reviewing = false
+				return m, m.saveBookCmd(), models.AddBookScreen
+			case "n", "esc":
+				m.reviewing = false
+			}
+			return m, nil, models.AddBookScreen
+		}
+
+		// Single-letter shortcuts pick a type, but only while the type selector is focused
+		if m.focused == len(m.inputs) {
+			if index, ok := typeIndexForKey(m.bookTypes, msg.String()); ok {
+				m.selectedType = index
+				return m, nil, models.AddBookScreen
+			}
+		}
+
 		switch msg.String() {
 		case "esc": // Escape key returns to main menu
 			m.err = nil     // Clear any error state
@@ -73,10 +143,36 @@ func (m AddBookModel) Update(msg tea.Msg) (AddBookModel, tea.Cmd, models.Screen)
 				m.inputs[m.focused].CursorEnd()
 			}
 			return m, nil, models.AddBookScreen
+		case "ctrl+u": // Clear the focused field, leaving the others as they are
+			if m.focused < len(m.inputs) {
+				m.inputs[m.focused].SetValue("")
+			} else if m.focused == len(m.inputs)+1 {
+				m.textarea.SetValue("")
+			}
+			return m, nil, models.AddBookScreen
+		case "ctrl+up", "ctrl+down": // Shrink or grow the notes field while it is focused
+			if m.focused == len(m.inputs)+1 {
+				m.err = resizeNotes(&m.textarea, msg.String() == "ctrl+down")
+			}
+			return m, nil, models.AddBookScreen
 		case "tab", "shift+tab", "enter", "up", "down":
 			s := msg.String()
 
+			// Tab in the author field accepts the current autocomplete suggestion
+			if s == "tab" && m.focused == 1 {
+				var cmd tea.Cmd
+				m.inputs[1], cmd = m.inputs[1].Update(msg)
+				return m, cmd, models.AddBookScreen
+			}
+
 			if s == "enter" && m.focused == len(m.inputs)+2 {
+				// Optionally show a summary first so the entry can be checked
+				if config.GetConfirmBeforeSave() {
+					m.err = nil
+					m.saved = false
+					m.reviewing = true
+					return m, nil, models.AddBookScreen
+				}
 				return m, m.saveBookCmd(), models.AddBookScreen
 			}
 
@@ -155,17 +251,52 @@ func (m AddBookModel) Update(msg tea.Msg) (AddBookModel, tea.Cmd, models.Screen)
 			// This will be handled by updateInputs() method
 		}
 
+	case messages.AuthorsMsg:
+		// Suggestions are optional, so a failed load simply leaves them empty
+		if msg.Err == nil {
+			m.inputs[1].SetSuggestions(msg.Authors)
+		}
+		return m, nil, models.AddBookScreen
+
+	case messages.DraftMsg:
+		// A draft that cannot be read is left in place rather than blocking the form
+		if msg.Err == nil && !msg.Draft.Empty() {
+			m.draft = msg.Draft
+			m.restoring = true
+		}
+		return m, nil, models.AddBookScreen
+
+	case messages.DraftSaveMsg:
+		// Save only once typing has paused, unless a newer change restarted the timer
+		if msg.Seq == m.draftSeq && !m.restoring {
+			return m, writeDraftCmd(m.currentDraft()), models.AddBookScreen
+		}
+		return m, nil, models.AddBookScreen
+
+	case messages.DismissMsg:
+		// Hide the saved message once its timeout passes, unless a newer save showed it again
+		if msg.Screen == models.AddBookScreen && msg.Seq == m.dismissSeq {
+			m.saved = false
+		}
+		return m, nil, models.AddBookScreen
+
 	case messages.SaveMsg:
 		if msg.Err != nil {
 			m.err = msg.Err
 		} else {
 			m.saved = true
+			m.lastType = m.bookTypes[m.selectedType] // The form reopens on this type
+			m.dismissSeq++
+			m.sessionCount++ // Track books added in this rapid-entry session
 			for i := range m.inputs {
 				m.inputs[i].SetValue("")
 			}
-			m.textarea.SetValue("")
+			m.textarea.SetValue(m.template)
 			m.focused = 0
 			m.inputs[0].Focus()
+			// Reload so an author added in this session is suggested next time,
+			// and drop the draft now that the book is stored
+			return m, tea.Batch(m.LoadAuthorsCmd(), dismissCmd(models.AddBookScreen, m.dismissSeq), writeDraftCmd(config.Draft{})), models.AddBookScreen
 		}
 		return m, nil, models.AddBookScreen
 	}
@@ -201,11 +332,18 @@ func (m AddBookModel) View() string {
 	var b strings.Builder
 
 	b.WriteString("\n")
-	b.WriteString(styles.TitleStyle().Render("Ｌｉｂｒｏｓ　－　Ａ　Ｂｏｏｋ　Ｍａｎａｇｅｒ"))
+	b.WriteString(styles.TitleStyle().Render(styles.Label(i18n.T(i18n.AppTitle))))
 	b.WriteString("\n\n")
-	b.WriteString(styles.BlurredStyle.Render("Ａｄｄ　Ｎｅｗ　Ｂｏｏｋ"))
+	b.WriteString(styles.BlurredStyle.Render(styles.Label(i18n.T(i18n.FormAddTitle))))
 	b.WriteString("\n\n")
 
+	if m.restoring {
+		return b.String() + m.restoreView()
+	}
+	if m.reviewing {
+		return b.String() + m.reviewView()
+	}
+
 	for i := range m.inputs {
 		b.WriteString(m.inputs[i].View())
 		if i == 0 {
@@ -217,7 +355,7 @@ func (m AddBookModel) View() string {
 
 	// Add book type selector
 	b.WriteString("\n")
-	typeLabel := "   " + styles.AddLetterSpacing("Type:") + "  "
+	typeLabel := "   " + styles.AddLetterSpacing(i18n.T(i18n.FormType)) + "  "
 	if m.focused == len(m.inputs) {
 		b.WriteString(styles.FormFocusedStyle().Render(typeLabel))
 	} else {
@@ -225,7 +363,7 @@ func (m AddBookModel) View() string {
 	}
 
 	for i, bookType := range m.bookTypes {
-		buttonText := fmt.Sprintf("  %s  ", styles.AddLetterSpacing(styles.CapitalizeBookType(string(bookType))))
+		buttonText := fmt.Sprintf("  %s  ", styles.AddLetterSpacing(typeName(bookType)))
 		if i == m.selectedType {
 			if m.focused == len(m.inputs) {
 				b.WriteString(styles.BookTypeSelectedStyle().Render(buttonText))
@@ -243,28 +381,103 @@ func (m AddBookModel) View() string {
 
 	// Add notes textarea
 	b.WriteString("\n")
-	b.WriteString(styles.FocusedStyle().Render(styles.AddLetterSpacing("Notes:") + " "))
+	b.WriteString(styles.FocusedStyle().Render(styles.AddLetterSpacing(i18n.T(i18n.FormNotes)) + " "))
 	b.WriteString("\n\n")
 	b.WriteString(m.textarea.View())
 
 	if m.focused == len(m.inputs)+2 {
-		fmt.Fprintf(&b, "\n\n%s\n\n", styles.ButtonStyle().Render(styles.AddLetterSpacing("SAVE BOOK")))
+		fmt.Fprintf(&b, "\n\n%s\n\n", styles.ButtonStyle().Render(styles.AddLetterSpacing(i18n.T(i18n.FormSave))))
 	} else {
-		fmt.Fprintf(&b, "\n\n%s\n\n", styles.BlurredStyle.Render(styles.AddLetterSpacing("SAVE BOOK")))
+		fmt.Fprintf(&b, "\n\n%s\n\n", styles.BlurredStyle.Render(styles.AddLetterSpacing(i18n.T(i18n.FormSave))))
 	}
 
 	if m.err != nil {
-		b.WriteString(styles.ErrorStyle.Render(styles.AddLetterSpacing("Error: " + m.err.Error())))
+		b.WriteString(styles.ErrorStyle.Render(styles.AddLetterSpacing(fmt.Sprintf(i18n.T(i18n.FormError), m.err))))
 		b.WriteString("\n")
 	}
 
 	if m.saved {
-		b.WriteString(styles.SuccessStyle.Render(styles.AddLetterSpacing("Book saved successfully!")))
+		b.WriteString(styles.SuccessStyle.Render(styles.AddLetterSpacing(i18n.T(i18n.FormSaved))))
 		b.WriteString("\n")
 	}
 
+	// Once a book has been saved the form stays open for rapid entry,
+	// so show how many books have been added during this session
+	if m.sessionCount > 0 {
+		b.WriteString("\n")
+		b.WriteString(styles.FocusedStyle().Render(styles.AddLetterSpacing(fmt.Sprintf(i18n.T(i18n.FormSession), m.sessionCount))))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	if m.focused == len(m.inputs) {
+		b.WriteString(renderHelp(help.AddType))
+	} else {
+		b.WriteString(renderHelp(help.Add))
+	}
+
+	return b.String()
+}
+
+// reviewView renders the summary shown before saving when confirm_before_save is on
+// Notes are truncated as in the book list, so long notes do not push the prompt off screen
+func (m AddBookModel) reviewView() string {
+	var b strings.Builder
+
+	location := strings.TrimSpace(m.inputs[2].Value())
+	if location == "" {
+		location = "—"
+	}
+	notes := strings.TrimSpace(m.stripNotesTemplate(m.textarea.Value()))
+	if notes == "" {
+		notes = "—"
+	} else {
+		notes = truncateNotes(notes, constants.TextWrapWidth)
+	}
+
+	fields := []struct{ label, value string }{
+		{i18n.T(i18n.FormTitle), strings.TrimSpace(m.inputs[0].Value())},
+		{i18n.T(i18n.FormAuthor), strings.TrimSpace(m.inputs[1].Value())},
+		{i18n.T(i18n.FormType), typeName(m.bookTypes[m.selectedType])},
+		{i18n.T(i18n.FormLocation), location},
+		{i18n.T(i18n.FormNotes), notes},
+	}
+	b.WriteString(styles.FocusedStyle().Render(styles.AddLetterSpacing(i18n.T(i18n.FormReview))))
+	b.WriteString("\n\n")
+	for _, field := range fields {
+		b.WriteString(styles.SpacedBlurredStyle.Render(styles.AddLetterSpacing(field.label)))
+		b.WriteString(styles.BookAuthorUnselectedStyle().Render(styles.AddLetterSpacing(field.value)))
+		b.WriteString("\n\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(renderHelp(help.AddReview))
+
+	return b.String()
+}
+
+// restoreView renders the prompt asking whether to restore the draft left by an unsaved entry
+func (m AddBookModel) restoreView() string {
+	var b strings.Builder
+
+	b.WriteString(styles.FocusedStyle().Render(styles.AddLetterSpacing(i18n.T(i18n.FormRestore))))
+	b.WriteString("\n\n")
+	fields := []struct{ label, value string }{
+		{i18n.T(i18n.FormTitle), m.draft.Title},
+		{i18n.T(i18n.FormAuthor), m.draft.Author},
+	}
+	for _, field := range fields {
+		value := strings.TrimSpace(field.value)
+		if value == "" {
+			value = "—"
+		}
+		b.WriteString(styles.SpacedBlurredStyle.Render(styles.AddLetterSpacing(field.label)))
+		b.WriteString(styles.BookAuthorUnselectedStyle().Render(styles.AddLetterSpacing(value)))
+		b.WriteString("\n\n")
+	}
+
 	b.WriteString("\n")
-	b.WriteString(styles.HelpTextStyle.Render(styles.AddLetterSpacing("Press Esc to return to menu, Ctrl+A/Ctrl+E for start/end of field, q or Ctrl+C to quit")))
+	b.WriteString(renderHelp(help.AddRestore))
 
 	return b.String()
 }
@@ -279,15 +492,163 @@ func (m AddBookModel) saveBookCmd() tea.Cmd {
 		author := m.inputs[1].Value()           // Get author from second input
 		bookType := m.bookTypes[m.selectedType] // Get selected book type
 		notes := m.textarea.Value()             // Get optional notes
+		location := m.inputs[2].Value()         // Get optional shelf location
+
+		// An unedited notes template counts as no notes
+		notes = m.stripNotesTemplate(notes)
 
 		// Attempt to save the book to database
-		err := m.db.SaveBook(title, author, bookType, notes)
+		err := m.db.SaveBook(title, author, bookType, notes, location)
 
 		// Return result message that will be handled by Update method
 		return messages.SaveMsg{Err: err}
 	}
 }
 
+// LoadDraftCmd creates a command that reads the draft left by an unsaved entry
+// It is run when the screen opens so the user can choose to restore it
+func (m AddBookModel) LoadDraftCmd() tea.Cmd {
+	return func() tea.Msg {
+		draft, err := config.LoadDraft()
+		return messages.DraftMsg{Draft: draft, Err: err}
+	}
+}
+
+// SaveDraft writes the current fields to the draft file straight away
+// It is called when the app quits from the form, before a pending save could run
+func (m AddBookModel) SaveDraft() {
+	if m.restoring {
+		return
+	}
+	// Failing to save only means the entry cannot be restored next time
+	_ = config.SaveDraft(m.currentDraft())
+}
+
+// currentDraft returns the form's fields as a draft
+// An unedited notes template counts as no notes, as when saving
+func (m AddBookModel) currentDraft() config.Draft {
+	return config.Draft{
+		Title:    m.inputs[0].Value(),
+		Author:   m.inputs[1].Value(),
+		Type:     m.bookTypes[m.selectedType],
+		Location: m.inputs[2].Value(),
+		Notes:    m.stripNotesTemplate(m.textarea.Value()),
+	}
+}
+
+// applyDraft fills the form with a restored draft, keeping the notes
+// template when the draft has no notes
+func (m *AddBookModel) applyDraft(draft config.Draft) {
+	m.inputs[0].SetValue(draft.Title)
+	m.inputs[1].SetValue(draft.Author)
+	m.inputs[2].SetValue(draft.Location)
+	for i, bookType := range m.bookTypes {
+		if bookType == draft.Type {
+			m.selectedType = i
+		}
+	}
+	if draft.Notes != "" {
+		m.textarea.SetValue(draft.Notes)
+	}
+	m.inputs[0].CursorEnd()
+}
+
+// draftSaveCmd returns a command that sends a DraftSaveMsg for the change
+// numbered seq once typing has paused for the draft save delay
+func draftSaveCmd(seq int) tea.Cmd {
+	return tea.Tick(constants.DraftSaveDelay*time.Millisecond, func(time.Time) tea.Msg {
+		return messages.DraftSaveMsg{Seq: seq}
+	})
+}
+
+// writeDraftCmd saves draft in the background; an empty draft removes the file
+func writeDraftCmd(draft config.Draft) tea.Cmd {
+	return func() tea.Msg {
+		// A draft is a safety net, so a failed write is not reported
+		_ = config.SaveDraft(draft)
+		return nil
+	}
+}
+
+// LoadAuthorsCmd creates a command that loads existing authors for autocomplete
+// It is run once when the screen opens so suggestions never query on each keystroke
+func (m AddBookModel) LoadAuthorsCmd() tea.Cmd {
+	return loadAuthorsCmd(m.db)
+}
+
+// loadAuthorsCmd loads the distinct authors in the collection and returns an AuthorsMsg
+// Shared by the add and edit screens to populate author suggestions
+func loadAuthorsCmd(db *database.DB) tea.Cmd {
+	return func() tea.Msg {
+		authors, err := db.GetAuthors()
+		return messages.AuthorsMsg{Authors: authors, Err: err}
+	}
+}
+
+// typeShortcuts maps the single-letter keys accepted by the type selector to book types
+var typeShortcuts = map[string]models.BookType{
+	"p": models.Paperback,
+	"h": models.Hardback,
+	"a": models.Audio,
+	"d": models.Digital,
+}
+
+// typeName returns the display name of bookType in the configured language
+// Shared by the add and edit forms, e.g. "Paperback" or, in Spanish, "Rústica"
+func typeName(bookType models.BookType) string {
+	return i18n.T(i18n.Key("type." + string(bookType)))
+}
+
+// typeIndexForKey returns the position in bookTypes of the type bound to key
+// Shared by the add and edit forms; ok is false for keys that are not type shortcuts
+func typeIndexForKey(bookTypes []models.BookType, key string) (int, bool) {
+	bookType, ok := typeShortcuts[key]
+	if !ok {
+		return 0, false
+	}
+	for i, t := range bookTypes {
+		if t == bookType {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// stripNotesTemplate returns the notes to save, treating an unedited notes
+// template as empty so a book is not saved with only the template's headings
+func (m AddBookModel) stripNotesTemplate(notes string) string {
+	template := strings.TrimSpace(m.template)
+	if template != "" && strings.TrimSpace(notes) == template {
+		return ""
+	}
+	return notes
+}
+
+// startTypeIndex returns the position of the type the form opens on: the type of
+// the last book saved when remember_type is on, otherwise the configured default
+func (m AddBookModel) startTypeIndex() int {
+	if m.lastType != "" && config.GetRememberType() {
+		for i, bookType := range m.bookTypes {
+			if bookType == m.lastType {
+				return i
+			}
+		}
+	}
+	return defaultTypeIndex(m.bookTypes)
+}
+
+// defaultTypeIndex returns the position of the configured default book type,
+// or 0 (Paperback) if it is not one of the offered types
+func defaultTypeIndex(bookTypes []models.BookType) int {
+	defaultType := config.GetDefaultBookType()
+	for i, bookType := range bookTypes {
+		if bookType == defaultType {
+			return i
+		}
+	}
+	return 0
+}
+
 // Reset clears all form data and returns the screen to its initial state
 // This is called when returning to the menu to prepare for the next book entry
 // All fields are cleared and focus returns to the title field
@@ -295,16 +656,25 @@ func (m *AddBookModel) Reset() {
 	// Clear all status flags
 	m.err = nil        // Clear any error messages
 	m.saved = false    // Clear saved confirmation
+	m.sessionCount = 0 // End the rapid-entry session
 	m.focused = 0      // Reset focus to title field
-	m.selectedType = 0 // Reset to first book type (Paperback)
+	m.reviewing = false
+	m.restoring = false
+	m.draft = config.Draft{}
+	m.draftSeq++ // Ignore a draft save still pending from before the reset
+
+	// Start on the type of the last book saved, or the configured default
+	m.selectedType = m.startTypeIndex()
 
 	// Clear all text input values
 	for i := range m.inputs {
 		m.inputs[i].SetValue("")
 	}
 
-	// Clear textarea notes
-	m.textarea.SetValue("")
+	// Restore the notes template, read again in case the config changed, sized as last chosen on either form
+	m.template = config.GetNotesTemplate()
+	m.textarea.SetValue(m.template)
+	m.textarea.SetHeight(config.GetNotesHeight())
 
 	// Reset focus styling - title field focused, others blurred
 	m.inputs[0].Focus()
@@ -320,3 +690,33 @@ func (m *AddBookModel) Reset() {
 
 	m.textarea.Blur()
 }
+
+// SetTitle pre-fills the title field, leaving it focused so it can still be edited
+// This is used when a book list search finds nothing and the user adds it as a new book
+func (m *AddBookModel) SetTitle(title string) {
+	m.inputs[0].SetValue(title)
+	m.inputs[0].CursorEnd()
+}
+
+// resizeNotes grows or shrinks the notes textarea by one line, within the allowed
+// range, and saves the new height so both forms open at that size next time.
+// The form is rendered top to bottom, so the fields below the notes move with it.
+//
+// Parameters:
+//   - ta: Notes textarea to resize
+//   - grow: Whether to add a line rather than remove one
+//
+// Returns:
+//   - error: Error saving the height, nil if successful or the size did not change
+func resizeNotes(ta *textarea.Model, grow bool) error {
+	height := ta.Height() - 1
+	if grow {
+		height = ta.Height() + 1
+	}
+	height = max(constants.NotesMinHeight, min(height, constants.NotesMaxHeight))
+	if height == ta.Height() {
+		return nil
+	}
+	ta.SetHeight(height)
+	return config.UpdateNotesHeight(height)
+}
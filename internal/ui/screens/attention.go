@@ -0,0 +1,208 @@
+package screens
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/papadavis47/libros/internal/config"
+	"github.com/papadavis47/libros/internal/constants"
+	"github.com/papadavis47/libros/internal/database"
+	"github.com/papadavis47/libros/internal/help"
+	"github.com/papadavis47/libros/internal/messages"
+	"github.com/papadavis47/libros/internal/models"
+	"github.com/papadavis47/libros/internal/styles"
+)
+
+// AttentionScreen reports how many books are missing each kind of information,
+// such as notes or a shelf location, as chosen by the attention config option.
+// Choosing a check lists its books, and choosing a book opens it on the edit
+// screen. The report itself never changes a book.
+type AttentionScreen struct {
+	db      *database.DB
+	loading bool
+	groups  []models.AttentionGroup
+	check   int  // Selected check
+	listing bool // Whether the books of the selected check are listed
+	index   int  // Selected book within the listed check
+	err     error
+}
+
+func NewAttentionScreen(db *database.DB) *AttentionScreen {
+	return &AttentionScreen{db: db}
+}
+
+// Start clears any previous report and checks the collection again.
+// It returns the command that runs the checks.
+func (s *AttentionScreen) Start() tea.Cmd {
+	s.check = 0
+	s.listing = false
+	s.index = 0
+	return s.Refresh()
+}
+
+// Refresh runs the checks again but keeps the selected check and book,
+// so coming back from editing a book continues where the user left off.
+// It returns the command that runs the checks.
+func (s *AttentionScreen) Refresh() tea.Cmd {
+	s.loading = true
+	s.err = nil
+	return s.checkBooksCmd()
+}
+
+// SelectedBook returns a copy of the book chosen from the listed check,
+// for the root model to open on the edit screen
+func (s *AttentionScreen) SelectedBook() *models.Book {
+	book := s.groups[s.check].Books[s.index]
+	return &book
+}
+
+func (s *AttentionScreen) Init() tea.Cmd {
+	return nil
+}
+
+func (s *AttentionScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		// Ignore input while the checks run
+		if s.loading {
+			return s, nil
+		}
+		if s.listing {
+			books := s.groups[s.check].Books
+			switch msg.String() {
+			case "up", "k":
+				if s.index > 0 {
+					s.index--
+				}
+			case "down", "j":
+				if s.index < len(books)-1 {
+					s.index++
+				}
+			case "enter":
+				return s, SwitchScreenCmd(models.EditBookScreen)
+			case "esc":
+				// Go back to the counts
+				s.listing = false
+			}
+			return s, nil
+		}
+		switch msg.String() {
+		case "up", "k":
+			if s.check > 0 {
+				s.check--
+			}
+		case "down", "j":
+			if s.check < len(s.groups)-1 {
+				s.check++
+			}
+		case "enter":
+			// List the books of the selected check, if it found any
+			if s.err == nil && len(s.groups) > 0 && len(s.groups[s.check].Books) > 0 {
+				s.listing = true
+				s.index = 0
+			}
+		case "esc":
+			// Return to utilities screen
+			return s, SwitchScreenCmd(models.UtilitiesScreen)
+		}
+
+	case messages.AttentionMsg:
+		s.loading = false
+		s.groups = msg.Groups
+		s.err = msg.Err
+		// Keep the selection in range, since an edit may have completed the last book of a check
+		s.check = min(s.check, max(len(s.groups)-1, 0))
+		if s.listing && (s.err != nil || len(s.groups) == 0 || len(s.groups[s.check].Books) == 0) {
+			s.listing = false
+		}
+		if s.listing {
+			s.index = min(s.index, len(s.groups[s.check].Books)-1)
+		}
+	}
+
+	return s, nil
+}
+
+func (s *AttentionScreen) View() string {
+	var b strings.Builder
+
+	b.WriteString("\n")
+	b.WriteString(styles.TitleStyle().Render(styles.Label("Ｎｅｅｄｓ　Ａｔｔｅｎｔｉｏｎ")))
+	b.WriteString("\n\n")
+
+	switch {
+	case s.loading:
+		b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing("Checking the collection...")))
+		b.WriteString("\n")
+		return b.String()
+	case s.err != nil:
+		b.WriteString(styles.ErrorStyle.Render(styles.AddLetterSpacing("Error: " + s.err.Error())))
+		b.WriteString("\n")
+		b.WriteString("\n" + renderHelp(help.Attention))
+		return b.String()
+	case s.listing:
+		s.writeBooks(&b)
+		b.WriteString("\n" + renderHelp(help.AttentionBooks))
+		return b.String()
+	}
+
+	complete := true
+	for i, group := range s.groups {
+		line := fmt.Sprintf("%s: %d", group.Check.Label, len(group.Books))
+		if i == s.check {
+			b.WriteString(styles.SelectedStyle().Render(styles.AddLetterSpacing(line)))
+		} else {
+			b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing(line)))
+		}
+		b.WriteString("\n\n")
+		if len(group.Books) > 0 {
+			complete = false
+		}
+	}
+	if complete {
+		b.WriteString(styles.SuccessStyle.Render(styles.AddLetterSpacing("Every book has the information checked")))
+		b.WriteString("\n")
+	}
+	b.WriteString("\n" + renderHelp(help.Attention))
+
+	return b.String()
+}
+
+// writeBooks lists the books of the selected check a page at a time,
+// keeping the selected book on the page shown
+func (s *AttentionScreen) writeBooks(b *strings.Builder) {
+	group := s.groups[s.check]
+	heading := fmt.Sprintf("%s (%d)", group.Check.Label, len(group.Books))
+	b.WriteString(styles.FocusedStyle().Render(styles.AddLetterSpacing(heading)))
+	b.WriteString("\n\n")
+
+	start := s.index / constants.AttentionListHeight * constants.AttentionListHeight
+	end := min(start+constants.AttentionListHeight, len(group.Books))
+	for i := start; i < end; i++ {
+		book := group.Books[i]
+		line := fmt.Sprintf("%s by %s", valueOr(book.Title, untitledPlaceholder), valueOr(book.Author, unknownAuthorPlaceholder))
+		if i == s.index {
+			b.WriteString(styles.SelectedStyle().Render(styles.AddLetterSpacing(line)))
+		} else {
+			b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing(line)))
+		}
+		b.WriteString("\n\n")
+	}
+	if len(group.Books) > constants.AttentionListHeight {
+		b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing(fmt.Sprintf("Showing %d-%d of %d", start+1, end, len(group.Books)))))
+		b.WriteString("\n")
+	}
+}
+
+// checkBooksCmd loads every book and runs the configured checks asynchronously,
+// reporting the result as an AttentionMsg.
+func (s *AttentionScreen) checkBooksCmd() tea.Cmd {
+	return func() tea.Msg {
+		books, err := s.db.LoadBooks()
+		if err != nil {
+			return messages.AttentionMsg{Err: err}
+		}
+		return messages.AttentionMsg{Groups: models.NeedsAttention(books, config.GetAttention())}
+	}
+}
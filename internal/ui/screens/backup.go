@@ -2,37 +2,44 @@ package screens
 
 import (
 	"os"
-	"path/filepath"
 	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/papadavis47/libros/internal/config"
 	"github.com/papadavis47/libros/internal/constants"
 	"github.com/papadavis47/libros/internal/database"
+	"github.com/papadavis47/libros/internal/help"
 	"github.com/papadavis47/libros/internal/models"
 	"github.com/papadavis47/libros/internal/styles"
 )
 
 type BackupScreen struct {
-	db      *database.DB
-	status  string
-	isError bool
-	done    bool
+	db         *database.DB
+	status     string
+	isError    bool
+	done       bool
+	confirming bool // Waiting for the user to allow overwriting an existing backup
 }
 
 func NewBackupScreen(db *database.DB) *BackupScreen {
-	screen := &BackupScreen{
+	return &BackupScreen{
 		db: db,
 	}
-	// Perform backup immediately when screen is created
-	screen.performBackupSync()
-	return screen
+}
+
+// Start clears any previous result and backs up the database.
+// It is called each time the screen is opened.
+func (s *BackupScreen) Start() {
+	s.ClearStatus()
+	s.performBackupSync(false)
 }
 
 func (s *BackupScreen) ClearStatus() {
 	s.status = ""
 	s.isError = false
 	s.done = false
+	s.confirming = false
 }
 
 func (s *BackupScreen) Init() tea.Cmd {
@@ -42,6 +49,17 @@ func (s *BackupScreen) Init() tea.Cmd {
 func (s *BackupScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		// While asking about an existing backup, y overwrites it and esc cancels
+		if s.confirming {
+			switch msg.String() {
+			case "y":
+				s.confirming = false
+				s.performBackupSync(true)
+			case "esc":
+				return s, SwitchScreenCmd(models.UtilitiesScreen)
+			}
+			return s, nil
+		}
 		switch msg.String() {
 		case "esc", "enter":
 			// Return to utilities screen
@@ -58,7 +76,7 @@ func (s *BackupScreen) View() string {
 	var b strings.Builder
 
 	b.WriteString("\n")
-	b.WriteString(styles.TitleStyle().Render("Ｄａｔａｂａｓｅ　Ｂａｃｋｕｐ"))
+	b.WriteString(styles.TitleStyle().Render(styles.Label("Ｄａｔａｂａｓｅ　Ｂａｃｋｕｐ")))
 	b.WriteString("\n\n")
 
 	// Show backup result
@@ -77,26 +95,40 @@ func (s *BackupScreen) View() string {
 		b.WriteString("\n")
 	}
 
+	if s.confirming {
+		b.WriteString(styles.FocusedStyle().Render(styles.AddLetterSpacing("A backup already exists at " + s.backupPath() + ". Overwrite it?")))
+		b.WriteString("\n")
+		b.WriteString("\n" + renderHelp(help.BackupConfirm))
+	}
+
 	if s.done {
-		b.WriteString("\n" + styles.HelpTextStyle.Render(styles.AddLetterSpacing("Press Enter or Esc to return to Utilities")))
+		b.WriteString("\n" + renderHelp(help.BackupDone))
 	}
 
 	return b.String()
 }
 
-func (s *BackupScreen) performBackupSync() {
-	s.done = true
+// backupPath returns where the open library is backed up, next to its
+// database file, e.g. ~/.libros/work.db.bak for work.db
+func (s *BackupScreen) backupPath() string {
+	return s.db.Path() + ".bak"
+}
 
-	// Get the database file path
-	homeDir, err := os.UserHomeDir()
-	if err != nil {
-		s.status = "Database backup failed: " + err.Error()
-		s.isError = true
-		return
+// performBackupSync copies the open library's database to its .bak file. When the
+// config disables overwriting and a backup already exists, it asks for confirmation
+// instead, unless overwrite is true because the user has already agreed.
+func (s *BackupScreen) performBackupSync(overwrite bool) {
+	dbPath := s.db.Path()
+	backupPath := s.backupPath()
+
+	// Keep an existing backup unless overwriting is allowed or confirmed
+	if !overwrite && !config.GetBackupOverwrite() {
+		if _, err := os.Stat(backupPath); err == nil {
+			s.confirming = true
+			return
+		}
 	}
-
-	dbPath := filepath.Join(homeDir, ".libros", "books.db")
-	backupPath := filepath.Join(homeDir, ".libros", "books.db.bak")
+	s.done = true
 
 	// Check if source database file exists
 	if _, err := os.Stat(dbPath); os.IsNotExist(err) {
@@ -106,14 +138,13 @@ func (s *BackupScreen) performBackupSync() {
 	}
 
 	// Copy the database file to backup location
-	err = copyFile(dbPath, backupPath)
-	if err != nil {
+	if err := copyFile(dbPath, backupPath); err != nil {
 		s.status = "Database backup failed: " + err.Error()
 		s.isError = true
 		return
 	}
 
-	s.status = "Database backed up successfully to ~/.libros/books.db.bak"
+	s.status = "Database backed up successfully to " + backupPath
 	s.isError = false
 }
 
@@ -0,0 +1,198 @@
+package screens
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textarea"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/papadavis47/libros/internal/database"
+	"github.com/papadavis47/libros/internal/factory"
+	"github.com/papadavis47/libros/internal/help"
+	"github.com/papadavis47/libros/internal/messages"
+	"github.com/papadavis47/libros/internal/models"
+	"github.com/papadavis47/libros/internal/services"
+	"github.com/papadavis47/libros/internal/styles"
+	"github.com/papadavis47/libros/internal/utils"
+)
+
+// BulkAddState represents the current step of the bulk add flow
+type BulkAddState int
+
+const (
+	BulkEntry   BulkAddState = iota // Typing or pasting the lines
+	BulkPreview                     // Showing each parsed row before confirming
+	BulkAdding                      // Currently adding the books
+	BulkResult                      // Showing the result (success/error)
+)
+
+// BulkAddScreen adds several books at once from pasted lines of "Title | Author | Type".
+// The lines are parsed into a preview first, with malformed rows flagged, and
+// only the valid rows are added once the user confirms.
+type BulkAddScreen struct {
+	db      *database.DB
+	state   BulkAddState
+	entry   textarea.Model
+	rows    []services.BulkRow // Rows parsed from the entry, waiting for confirmation
+	status  string
+	isError bool
+}
+
+func NewBulkAddScreen(db *database.DB) *BulkAddScreen {
+	return &BulkAddScreen{
+		db:    db,
+		entry: factory.CreateBulkEntryTextArea(),
+	}
+}
+
+// Reset clears the previous entry and result so the screen starts fresh
+// each time it is opened. It returns the command that focuses the textarea.
+func (s *BulkAddScreen) Reset() tea.Cmd {
+	s.state = BulkEntry
+	s.entry.Reset()
+	s.rows = nil
+	s.status = ""
+	s.isError = false
+	return s.entry.Focus()
+}
+
+func (s *BulkAddScreen) Init() tea.Cmd {
+	return textarea.Blink
+}
+
+func (s *BulkAddScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch s.state {
+		case BulkEntry:
+			switch msg.String() {
+			case "ctrl+s":
+				// Enter starts a new line, so the preview has its own key
+				s.rows = services.ParseBulkEntry(s.entry.Value())
+				if len(s.rows) == 0 {
+					s.status = "Please enter at least one line"
+					s.isError = true
+					return s, nil
+				}
+				s.status = ""
+				s.isError = false
+				s.entry.Blur()
+				s.state = BulkPreview
+				return s, nil
+			case "esc":
+				return s, SwitchScreenCmd(models.UtilitiesScreen)
+			}
+		case BulkPreview:
+			switch msg.String() {
+			case "y":
+				books := services.ValidBulkBooks(s.rows)
+				if len(books) == 0 {
+					return s, nil
+				}
+				s.state = BulkAdding
+				return s, s.addBooksCmd(books)
+			case "n", "esc":
+				// Go back and fix the lines, which are kept as typed
+				s.state = BulkEntry
+				return s, s.entry.Focus()
+			}
+			return s, nil
+		case BulkResult:
+			switch msg.String() {
+			case "enter", "esc":
+				return s, SwitchScreenCmd(models.UtilitiesScreen)
+			}
+			return s, nil
+		default:
+			// Ignore input while the books are added
+			return s, nil
+		}
+
+	case messages.ImportMsg:
+		s.state = BulkResult
+		if msg.Err != nil {
+			s.status = "Adding books failed: " + msg.Err.Error()
+			s.isError = true
+		} else {
+			s.status = fmt.Sprintf("Added %d book(s)", msg.Imported)
+			s.isError = false
+		}
+		return s, nil
+	}
+
+	var cmd tea.Cmd
+	if s.state == BulkEntry {
+		s.entry, cmd = s.entry.Update(msg)
+	}
+	return s, cmd
+}
+
+func (s *BulkAddScreen) View() string {
+	var b strings.Builder
+
+	b.WriteString("\n")
+	b.WriteString(styles.TitleStyle().Render(styles.Label("Ｂｕｌｋ　Ａｄｄ")))
+	b.WriteString("\n\n")
+
+	statusStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#00FF00")).
+		Bold(true).
+		Padding(1, 0).
+		PaddingLeft(3)
+	if s.isError {
+		statusStyle = statusStyle.Foreground(lipgloss.Color("#FF0000"))
+	}
+
+	switch s.state {
+	case BulkEntry:
+		b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing("Type or paste one \"Title | Author | Type\" per line. The type is optional and defaults to paperback:")))
+		b.WriteString("\n\n")
+		b.WriteString(s.entry.View())
+		b.WriteString("\n")
+		if s.status != "" {
+			b.WriteString(statusStyle.Render(styles.AddLetterSpacing(s.status)))
+			b.WriteString("\n")
+		}
+		b.WriteString("\n" + renderHelp(help.BulkAdd))
+
+	case BulkPreview:
+		valid := len(services.ValidBulkBooks(s.rows))
+		for _, row := range s.rows {
+			if row.Err != nil {
+				b.WriteString(styles.ErrorStyle.Render(styles.AddLetterSpacing(fmt.Sprintf("✗ Line %d: %s (%v)", row.Line, row.Text, row.Err))))
+			} else {
+				b.WriteString(styles.SuccessStyle.Render(styles.AddLetterSpacing(fmt.Sprintf("✓ Line %d: %s by %s, %s", row.Line, row.Book.Title, row.Book.Author, utils.FormatBookType(row.Book.Type)))))
+			}
+			b.WriteString("\n")
+		}
+		b.WriteString("\n")
+		b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing(fmt.Sprintf("%d book(s) will be added, %d line(s) skipped", valid, len(s.rows)-valid))))
+		b.WriteString("\n")
+		if valid == 0 {
+			b.WriteString("\n" + renderHelp(help.BulkAddPreviewEmpty))
+		} else {
+			b.WriteString("\n" + renderHelp(help.BulkAddPreview))
+		}
+
+	case BulkAdding:
+		b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing("Adding books...")))
+		b.WriteString("\n")
+
+	case BulkResult:
+		b.WriteString(statusStyle.Render(styles.AddLetterSpacing(s.status)))
+		b.WriteString("\n")
+		b.WriteString("\n" + renderHelp(help.BulkAddResult))
+	}
+
+	return b.String()
+}
+
+// addBooksCmd adds the valid rows asynchronously in one transaction,
+// reporting the result as an ImportMsg.
+func (s *BulkAddScreen) addBooksCmd(books []models.Book) tea.Cmd {
+	return func() tea.Msg {
+		added, err := s.db.ImportBooks(books)
+		return messages.ImportMsg{Imported: added, Err: err}
+	}
+}
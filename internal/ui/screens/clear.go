@@ -0,0 +1,161 @@
+package screens
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/papadavis47/libros/internal/database"
+	"github.com/papadavis47/libros/internal/factory"
+	"github.com/papadavis47/libros/internal/help"
+	"github.com/papadavis47/libros/internal/messages"
+	"github.com/papadavis47/libros/internal/models"
+	"github.com/papadavis47/libros/internal/styles"
+)
+
+// clearConfirmWord is the exact text the user must type to clear the collection
+const clearConfirmWord = "DELETE"
+
+// clearBackupPath returns the file a library's database is copied to before it is
+// cleared, next to it and named after it, e.g. work.db.before-clear.bak for work.db
+func clearBackupPath(dbPath string) string {
+	return dbPath + ".before-clear.bak"
+}
+
+// ClearScreen deletes every book in the collection after the user types
+// DELETE to confirm. A copy of the database file is written first so the
+// collection can be restored if the clear was a mistake.
+type ClearScreen struct {
+	db      *database.DB
+	input   textinput.Model
+	status  string
+	running bool
+	empty   bool // Whether the collection had no books to clear when the screen was opened
+}
+
+func NewClearScreen(db *database.DB) *ClearScreen {
+	return &ClearScreen{
+		db:    db,
+		input: factory.CreateTextInput(clearConfirmWord, len(clearConfirmWord)),
+	}
+}
+
+// Reset clears the confirmation input and any previous error so the
+// screen starts fresh each time it is opened.
+func (s *ClearScreen) Reset() tea.Cmd {
+	s.input.Reset()
+	s.status = ""
+	s.running = false
+	// An empty collection has nothing to clear; a failed count still offers the clear
+	count, err := s.db.GetBookCount()
+	s.empty = err == nil && count == 0
+	return s.input.Focus()
+}
+
+func (s *ClearScreen) Init() tea.Cmd {
+	return nil
+}
+
+func (s *ClearScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		// Ignore input while the backup and clear are in progress
+		if s.running {
+			return s, nil
+		}
+		// With nothing to clear, Enter and Esc both go back
+		if s.empty {
+			if msg.String() == "esc" || msg.String() == "enter" {
+				return s, SwitchScreenCmd(models.UtilitiesScreen)
+			}
+			return s, nil
+		}
+		switch msg.String() {
+		case "esc":
+			// Return to utilities screen without changing anything
+			return s, SwitchScreenCmd(models.UtilitiesScreen)
+		case "enter":
+			// Only the exact confirmation word clears the collection
+			if s.input.Value() != clearConfirmWord {
+				s.status = "Type " + clearConfirmWord + " exactly to confirm"
+				return s, nil
+			}
+			s.running = true
+			s.status = ""
+			return s, s.clearCollectionCmd()
+		}
+
+	case messages.ClearCollectionMsg:
+		s.running = false
+		if msg.Err != nil {
+			s.status = "Clear failed: " + msg.Err.Error()
+			return s, nil
+		}
+		// Collection cleared - head back to the main menu
+		return s, SwitchScreenCmd(models.MenuScreen)
+	}
+
+	var cmd tea.Cmd
+	s.input, cmd = s.input.Update(msg)
+	// Editing the confirmation clears any previous error
+	if _, ok := msg.(tea.KeyMsg); ok {
+		s.status = ""
+	}
+	return s, cmd
+}
+
+func (s *ClearScreen) View() string {
+	var b strings.Builder
+
+	b.WriteString("\n")
+	b.WriteString(styles.TitleStyle().Render(styles.Label("Ｃｌｅａｒ　Ｃｏｌｌｅｃｔｉｏｎ")))
+	b.WriteString("\n\n")
+
+	if s.empty {
+		b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing("There are no books to clear.")))
+		b.WriteString("\n")
+		b.WriteString("\n" + renderHelp(help.ClearEmpty))
+		return b.String()
+	}
+
+	b.WriteString(styles.ErrorStyle.Render(styles.AddLetterSpacing("This permanently deletes every book in your collection.")))
+	b.WriteString("\n")
+	b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing("A backup is saved to " + clearBackupPath(s.db.Path()) + " first.")))
+	b.WriteString("\n\n")
+
+	b.WriteString(styles.FocusedStyle().Render(styles.AddLetterSpacing("Type " + clearConfirmWord + " to confirm:")))
+	b.WriteString("\n")
+	b.WriteString(s.input.View())
+	b.WriteString("\n")
+
+	if s.running {
+		b.WriteString("\n" + styles.BlurredStyle.Render(styles.AddLetterSpacing("Backing up and clearing collection...")))
+		b.WriteString("\n")
+	} else if s.status != "" {
+		statusStyle := lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#FF0000")).
+			Bold(true).
+			Padding(1, 0).
+			PaddingLeft(3)
+		b.WriteString(statusStyle.Render(styles.AddLetterSpacing(s.status)))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n" + renderHelp(help.Clear))
+
+	return b.String()
+}
+
+// clearCollectionCmd backs up the database file and then deletes every book.
+// The clear is skipped if the backup cannot be written.
+func (s *ClearScreen) clearCollectionCmd() tea.Cmd {
+	return func() tea.Msg {
+		backupPath := clearBackupPath(s.db.Path())
+		if err := copyFile(s.db.Path(), backupPath); err != nil {
+			return messages.ClearCollectionMsg{BackupPath: backupPath, Err: err}
+		}
+		err := s.db.DeleteAllBooks()
+		return messages.ClearCollectionMsg{BackupPath: backupPath, Err: err}
+	}
+}
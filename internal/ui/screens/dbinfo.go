@@ -0,0 +1,110 @@
+package screens
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/papadavis47/libros/internal/database"
+	"github.com/papadavis47/libros/internal/help"
+	"github.com/papadavis47/libros/internal/messages"
+	"github.com/papadavis47/libros/internal/models"
+	"github.com/papadavis47/libros/internal/styles"
+	"github.com/papadavis47/libros/internal/utils"
+)
+
+// DBInfoScreen shows read-only details about the database file, such as its
+// path, size and schema version, to help with debugging and support.
+type DBInfoScreen struct {
+	db      *database.DB
+	loading bool
+	info    models.DBInfo
+	err     error
+}
+
+func NewDBInfoScreen(db *database.DB) *DBInfoScreen {
+	return &DBInfoScreen{db: db}
+}
+
+// Start clears any previous result and reads fresh database details.
+// It returns the command that gathers them.
+func (s *DBInfoScreen) Start() tea.Cmd {
+	s.loading = true
+	s.info = models.DBInfo{}
+	s.err = nil
+	return s.loadInfoCmd()
+}
+
+func (s *DBInfoScreen) Init() tea.Cmd {
+	return nil
+}
+
+func (s *DBInfoScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "esc", "enter":
+			// Return to utilities screen
+			return s, SwitchScreenCmd(models.UtilitiesScreen)
+		case "q", "ctrl+c":
+			return s, tea.Quit
+		}
+
+	case messages.DBInfoMsg:
+		s.loading = false
+		s.info = msg.Info
+		s.err = msg.Err
+	}
+
+	return s, nil
+}
+
+func (s *DBInfoScreen) View() string {
+	var b strings.Builder
+
+	b.WriteString("\n")
+	b.WriteString(styles.TitleStyle().Render(styles.Label("Ｄａｔａｂａｓｅ　Ｉｎｆｏ")))
+	b.WriteString("\n\n")
+
+	switch {
+	case s.loading:
+		b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing("Reading database details...")))
+		b.WriteString("\n")
+	case s.err != nil:
+		b.WriteString(styles.ErrorStyle.Render(styles.AddLetterSpacing("Error: " + s.err.Error())))
+		b.WriteString("\n")
+	default:
+		// The path is shown without letter spacing so it can be copied as is
+		b.WriteString(styles.SpacedBlurredStyle.Render(styles.AddLetterSpacing("File:")))
+		b.WriteString(styles.BookAuthorUnselectedStyle().Render(s.info.Path))
+		b.WriteString("\n\n")
+
+		size := utils.FormatFileSize(s.info.Size)
+		if s.info.SizeErr != nil {
+			size = "unavailable (" + s.info.SizeErr.Error() + ")"
+		}
+		s.writeInfo(&b, "Size:", size)
+		s.writeInfo(&b, "Schema version:", fmt.Sprintf("%d", s.info.SchemaVersion))
+		s.writeInfo(&b, "SQLite version:", s.info.SQLiteVersion)
+		s.writeInfo(&b, "Books:", fmt.Sprintf("%d rows", s.info.BookCount))
+	}
+
+	b.WriteString("\n" + renderHelp(help.DBInfo))
+
+	return b.String()
+}
+
+// writeInfo renders one labelled detail on its own line
+func (s *DBInfoScreen) writeInfo(b *strings.Builder, label, value string) {
+	b.WriteString(styles.SpacedBlurredStyle.Render(styles.AddLetterSpacing(label)))
+	b.WriteString(styles.BookAuthorUnselectedStyle().Render(styles.AddLetterSpacing(value)))
+	b.WriteString("\n\n")
+}
+
+// loadInfoCmd gathers the database details asynchronously and reports them as a DBInfoMsg.
+func (s *DBInfoScreen) loadInfoCmd() tea.Cmd {
+	return func() tea.Msg {
+		info, err := s.db.GetDatabaseInfo()
+		return messages.DBInfoMsg{Info: info, Err: err}
+	}
+}
@@ -4,12 +4,20 @@
 package screens
 
 import (
+	"fmt"
 	"strings"
 	"time"
 
+	"github.com/atotto/clipboard"
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 	"github.com/papadavis47/libros/internal/constants"
 	"github.com/papadavis47/libros/internal/database"
+	"github.com/papadavis47/libros/internal/factory"
+	"github.com/papadavis47/libros/internal/help"
+	"github.com/papadavis47/libros/internal/i18n"
 	"github.com/papadavis47/libros/internal/messages"
 	"github.com/papadavis47/libros/internal/models"
 	"github.com/papadavis47/libros/internal/styles"
@@ -19,12 +27,23 @@ import (
 // DetailModel represents the book detail screen that shows comprehensive information
 // about a selected book and provides actions for editing, deleting, or navigation.
 type DetailModel struct {
-	db           *database.DB // Database connection for book operations
-	SelectedBook *models.Book // Currently displayed book (set by navigation from list screen)
-	actions      []string     // Available actions (Edit, Delete, Back to List)
-	index        int          // Currently selected action index (0-based)
-	err          error        // Any error from book operations (deletion, etc.)
-	updated      bool         // Flag indicating if book was recently updated (for showing success message)
+	db           *database.DB    // Database connection for book operations
+	SelectedBook *models.Book    // Currently displayed book (set by navigation from list screen)
+	books        []*models.Book  // Books in list order, used for next/previous navigation
+	position     int             // Index of SelectedBook within books
+	authorCount  int             // Books by SelectedBook's author, including it; -1 until counted
+	actions      []string        // Available actions (Edit, Delete, Back to List)
+	index        int             // Currently selected action index (0-based)
+	err          error           // Any error from book operations (deletion, etc.)
+	updated      bool            // Flag indicating if book was recently updated (for showing success message)
+	listChanged  bool            // Whether a pin, reading, ownership or lending change means the list must be reloaded
+	copied       string          // Citation last copied to the clipboard, shown until the next key press
+	notes        viewport.Model  // Scrollable notes area, used when notes are too long to show inline
+	notesFocused bool            // Whether keys scroll the notes instead of moving through actions
+	height       int             // Terminal height, zero until the first window size message
+	lending      bool            // Whether the borrower's name is being typed to lend the book
+	borrower     textinput.Model // Borrower's name typed when lending the book
+	dismissSeq   int             // Counts updated messages, so only the latest one's timer hides it
 }
 
 // NewDetailModel creates and initializes a new DetailModel instance.
@@ -40,51 +59,14 @@ func NewDetailModel(db *database.DB) DetailModel {
 	return DetailModel{
 		db: db,
 		// Define available actions for the selected book
-		actions: []string{"Edit Book", "Delete Book", "Back to List"},
-		index:   0, // Start with first action selected
+		actions:     []string{"Edit Book", "Pin to Top", "Mark as Reading", "Move to Wishlist", "Lend Book", "View History", "Delete Book", "Back to List"},
+		index:       0,  // Start with first action selected
+		authorCount: -1, // Counted once a book is shown
+		notes:       viewport.New(0, constants.NotesViewportHeight),
+		borrower:    factory.CreateTextInput("Borrower's name", constants.BorrowerMaxLength),
 	}
 }
 
-// wrapText wraps long text to fit within a specified width by breaking at word boundaries.
-// This ensures that long notes are displayed properly in the terminal without horizontal scrolling.
-// It preserves word integrity by only breaking at spaces.
-//
-// Parameters:
-//   - text: Original text to wrap
-//   - width: Maximum line width in characters
-//
-// Returns:
-//   - string: Text with newlines inserted to fit within specified width
-func wrapText(text string, width int) string {
-	if len(text) <= width {
-		return text // No wrapping needed
-	}
-
-	var result []string
-	words := strings.Fields(text) // Split into words
-	if len(words) == 0 {
-		return text // Handle edge case of empty or whitespace-only text
-	}
-
-	// Start first line with first word
-	currentLine := words[0]
-	for _, word := range words[1:] {
-		// Check if adding this word would exceed width
-		if len(currentLine)+1+len(word) <= width {
-			// Add word to current line
-			currentLine += " " + word
-		} else {
-			// Start new line with this word
-			result = append(result, currentLine)
-			currentLine = word
-		}
-	}
-	// Add the final line
-	result = append(result, currentLine)
-
-	return strings.Join(result, "\n")
-}
-
 // Update handles user input and system messages for the book detail screen.
 // It processes navigation between actions, executes selected actions (edit, delete, back),
 // and handles responses from update and delete operations.
@@ -99,9 +81,35 @@ func wrapText(text string, width int) string {
 func (m DetailModel) Update(msg tea.Msg) (DetailModel, tea.Cmd, models.Screen) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		// While lending, keys type the borrower's name
+		if m.lending {
+			return m.updateBorrower(msg)
+		}
+
+		m.syncNotes()
+		m.copied = ""
+
 		switch msg.String() {
 		case "esc": // Return to book list
-			return m, nil, models.ListBooksScreen
+			return m, m.backToListCmd(), models.ListBooksScreen
+		case "tab": // Switch focus between the actions and long, scrollable notes
+			if m.scrollableNotes() {
+				m.notesFocused = !m.notesFocused
+			}
+			return m, nil, models.BookDetailScreen
+		}
+
+		// While the notes are focused, scrolling keys move through the notes
+		if m.notesFocused {
+			switch msg.String() {
+			case "up", "k", "down", "j", "pgup", "pgdown":
+				var cmd tea.Cmd
+				m.notes, cmd = m.notes.Update(msg)
+				return m, cmd, models.BookDetailScreen
+			}
+		}
+
+		switch msg.String() {
 		case "up", "k": // Move action selection up
 			if m.index > 0 {
 				m.index--
@@ -110,18 +118,51 @@ func (m DetailModel) Update(msg tea.Msg) (DetailModel, tea.Cmd, models.Screen) {
 			if m.index < len(m.actions)-1 {
 				m.index++
 			}
+		case "c": // Copy an APA citation for the book to the clipboard
+			return m, m.copyCitationCmd(utils.CitationAPA), models.BookDetailScreen
+		case "m": // Copy an MLA citation for the book to the clipboard
+			return m, m.copyCitationCmd(utils.CitationMLA), models.BookDetailScreen
+		case "n": // Show the next book in list order, stopping at the last one
+			if m.position < len(m.books)-1 {
+				return m, m.showBook(m.position + 1), models.BookDetailScreen
+			}
+		case "p": // Show the previous book in list order, stopping at the first one
+			if m.position > 0 {
+				return m, m.showBook(m.position - 1), models.BookDetailScreen
+			}
 		case "enter": // Execute selected action
 			selectedAction := m.actions[m.index]
 			switch selectedAction {
 			case "Edit Book":
 				// Navigate to edit screen
 				return m, nil, models.EditBookScreen
+			case "Pin to Top":
+				// Toggle the pin and stay on detail screen to show the result
+				return m, m.togglePinCmd(), models.BookDetailScreen
+			case "Mark as Reading":
+				// Toggle the currently reading mark and stay on detail screen
+				return m, m.toggleReadingCmd(), models.BookDetailScreen
+			case "Move to Wishlist":
+				// Toggle between owned and wishlist and stay on detail screen
+				return m, m.toggleOwnedCmd(), models.BookDetailScreen
+			case "Lend Book":
+				// A lent book is returned straight away; otherwise ask who it is lent to
+				if m.SelectedBook.LentTo != "" {
+					return m, m.returnBookCmd(), models.BookDetailScreen
+				}
+				m.lending = true
+				m.err = nil
+				m.borrower.Reset()
+				return m, m.borrower.Focus(), models.BookDetailScreen
+			case "View History":
+				// Navigate to the earlier versions saved by edits
+				return m, nil, models.HistoryScreen
 			case "Delete Book":
 				// Execute delete command and stay on detail screen to show result
 				return m, m.deleteBookCmd(), models.BookDetailScreen
 			case "Back to List":
 				// Return to book list
-				return m, nil, models.ListBooksScreen
+				return m, m.backToListCmd(), models.ListBooksScreen
 			}
 		}
 
@@ -130,8 +171,71 @@ func (m DetailModel) Update(msg tea.Msg) (DetailModel, tea.Cmd, models.Screen) {
 			// Store error for display
 			m.err = msg.Err
 		} else {
-			// Set flag to show success message
+			// Set flag to show success message, and count again in case the author changed
 			m.updated = true
+			m.dismissSeq++
+			return m, tea.Batch(dismissCmd(models.BookDetailScreen, m.dismissSeq), m.countAuthorCmd()), models.BookDetailScreen
+		}
+
+	case messages.AuthorCountMsg: // Handle the count of books by this author
+		// A failed count, or one for a book no longer shown, leaves the stats line without it
+		if msg.Err == nil && m.SelectedBook != nil && msg.BookID == m.SelectedBook.ID {
+			m.authorCount = msg.Count
+		}
+
+	case messages.DismissMsg:
+		// Hide the updated message once its timeout passes, unless a newer update showed it again
+		if msg.Screen == models.BookDetailScreen && msg.Seq == m.dismissSeq {
+			m.updated = false
+		}
+
+	case messages.PinMsg: // Handle pin toggle result
+		if msg.Err != nil {
+			// Store error for display
+			m.err = msg.Err
+		} else {
+			// The book is shared with the list, which is re-sorted on return
+			m.SelectedBook.Pinned = msg.Pinned
+			m.listChanged = true
+		}
+
+	case messages.ReadingMsg: // Handle currently reading toggle result
+		if msg.Err != nil {
+			// Store error for display
+			m.err = msg.Err
+		} else {
+			// Marking this book unmarks any other, so the list is reloaded on return
+			m.SelectedBook.Reading = msg.Reading
+			m.listChanged = true
+		}
+
+	case messages.OwnedMsg: // Handle owned/wishlist toggle result
+		if msg.Err != nil {
+			// Store error for display
+			m.err = msg.Err
+		} else {
+			// Reload the list on return, since it may be showing only the wishlist
+			m.SelectedBook.Owned = msg.Owned
+			m.listChanged = true
+		}
+
+	case messages.LendMsg: // Handle lend or return result
+		if msg.Err != nil {
+			// Store error for display
+			m.err = msg.Err
+		} else {
+			// Reload the list on return, since it may be showing only lent books
+			m.SelectedBook.LentTo = msg.LentTo
+			m.SelectedBook.LentDate = msg.LentDate
+			m.listChanged = true
+		}
+
+	case messages.CitationMsg: // Handle citation copy result
+		if msg.Err != nil {
+			// Store error for display
+			m.err = msg.Err
+		} else {
+			m.copied = msg.Citation
 		}
 
 	case messages.DeleteMsg: // Handle book deletion result
@@ -140,7 +244,15 @@ func (m DetailModel) Update(msg tea.Msg) (DetailModel, tea.Cmd, models.Screen) {
 			m.err = msg.Err
 		} else {
 			// Successfully deleted - refresh book list and return to it
-			return m, m.loadBooksCmd(), models.ListBooksScreen
+			return m, reloadBooksCmd, models.ListBooksScreen
+		}
+
+	default:
+		// Keep the borrower input's cursor blinking
+		if m.lending {
+			var cmd tea.Cmd
+			m.borrower, cmd = m.borrower.Update(msg)
+			return m, cmd, models.BookDetailScreen
 		}
 	}
 
@@ -148,6 +260,31 @@ func (m DetailModel) Update(msg tea.Msg) (DetailModel, tea.Cmd, models.Screen) {
 	return m, nil, models.BookDetailScreen
 }
 
+// updateBorrower handles keys while the borrower's name is typed.
+// Enter lends the book and Esc goes back to the actions without lending it.
+func (m DetailModel) updateBorrower(msg tea.KeyMsg) (DetailModel, tea.Cmd, models.Screen) {
+	switch msg.String() {
+	case "enter":
+		if strings.TrimSpace(m.borrower.Value()) == "" {
+			m.err = fmt.Errorf("a borrower name is required")
+			return m, nil, models.BookDetailScreen
+		}
+		m.err = nil
+		m.lending = false
+		m.borrower.Blur()
+		return m, m.lendBookCmd(m.borrower.Value()), models.BookDetailScreen
+	case "esc":
+		m.err = nil
+		m.lending = false
+		m.borrower.Blur()
+		return m, nil, models.BookDetailScreen
+	}
+
+	var cmd tea.Cmd
+	m.borrower, cmd = m.borrower.Update(msg)
+	return m, cmd, models.BookDetailScreen
+}
+
 // View renders the book detail screen with comprehensive book information and available actions.
 // It shows all book metadata, creation/update dates, full notes (with text wrapping),
 // and a menu of actions. The screen also displays success/error messages as needed.
@@ -155,15 +292,40 @@ func (m DetailModel) Update(msg tea.Msg) (DetailModel, tea.Cmd, models.Screen) {
 // Returns:
 //   - string: Formatted book detail screen ready for terminal display
 func (m DetailModel) View() string {
+	return m.Header() + m.Body()
+}
+
+// Header renders the application title and screen subtitle,
+// which stay in place when the header is pinned.
+//
+// Returns:
+//   - string: Title lines shown at the top of the detail screen
+func (m DetailModel) Header() string {
 	var b strings.Builder
 
 	// Display application title and screen subtitle
 	b.WriteString("\n")
-	b.WriteString(styles.TitleStyle().Render("Ｌｉｂｒｏｓ　－　Ａ　Ｂｏｏｋ　Ｍａｎａｇｅｒ"))
+	b.WriteString(styles.TitleStyle().Render(styles.Label(i18n.T(i18n.AppTitle))))
 	b.WriteString("\n\n")
-	b.WriteString(styles.BlurredStyle.Render("Ｂｏｏｋ　Ｄｅｔａｉｌｓ"))
+	b.WriteString(styles.BlurredStyle.Render(styles.Label("Ｂｏｏｋ　Ｄｅｔａｉｌｓ")))
 	b.WriteString("\n\n")
 
+	return b.String()
+}
+
+// Body renders the book information, actions, messages and help text below the header.
+// With a pinned header this is the part that scrolls on short terminals.
+//
+// Returns:
+//   - string: Detail screen content below the title lines
+func (m DetailModel) Body() string {
+	var b strings.Builder
+
+	// Show where this book sits in the list and among the author's books
+	if stats := m.statsLine(); stats != "" {
+		b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing(stats)) + "\n\n")
+	}
+
 	if m.SelectedBook != nil {
 		// Format the creation and update dates
 		createdStr := utils.FormatDate(m.SelectedBook.CreatedAt)
@@ -180,21 +342,55 @@ func (m DetailModel) View() string {
 		// Display all book metadata with labels
 		b.WriteString(styles.FocusedStyle().Render(styles.AddLetterSpacing("Title: ")) + styles.AddLetterSpacing(m.SelectedBook.Title) + "\n")
 		b.WriteString(styles.FocusedStyle().Render(styles.AddLetterSpacing("Author: ")) + styles.AddLetterSpacing(m.SelectedBook.Author) + "\n")
-		b.WriteString(styles.FocusedStyle().Render(styles.AddLetterSpacing("Type: ")) + styles.AddLetterSpacing(styles.CapitalizeBookType(string(m.SelectedBook.Type))) + "\n")
+		b.WriteString(styles.FocusedStyle().Render(styles.AddLetterSpacing("Type: ")) + styles.TypeLabel(m.SelectedBook.Type) + "\n")
+		// Location is optional and only shown when set
+		if m.SelectedBook.Location != "" {
+			b.WriteString(styles.FocusedStyle().Render(styles.AddLetterSpacing("Location: ")) + styles.AddLetterSpacing(m.SelectedBook.Location) + "\n")
+		}
+		// Likewise who the book is lent to, and since when
+		if m.SelectedBook.LentTo != "" {
+			b.WriteString(styles.FocusedStyle().Render(styles.AddLetterSpacing("Lent to: ")) + styles.AddLetterSpacing(lentLabel(*m.SelectedBook)) + "\n")
+		}
 
 		// Display notes if they exist, with text wrapping for readability
 		if m.SelectedBook.Notes != "" {
 			b.WriteString("\n")
 			b.WriteString(styles.FocusedStyle().Render(styles.AddLetterSpacing("Notes: ")) + "\n\n")
-			// Wrap long notes to fit terminal width and add quotation marks
-			wrappedNotes := wrapText(m.SelectedBook.Notes, constants.TextWrapWidth)
-			b.WriteString(styles.SpacedNotesStyle.Render("\""+styles.AddLetterSpacing(wrappedNotes)+"\"") + "\n")
+			// Short notes render inline; long notes scroll within a viewport
+			if m.scrollableNotes() {
+				m.syncNotes()
+				b.WriteString(m.notes.View() + "\n")
+				indicator := fmt.Sprintf("Lines %d-%d of %d", m.notes.YOffset+1, m.notes.YOffset+m.notes.VisibleLineCount(), m.notes.TotalLineCount())
+				if m.notesFocused {
+					b.WriteString(styles.FocusedStyle().Render(styles.AddLetterSpacing(indicator+" · j/k or PgUp/PgDn to scroll")) + "\n")
+				} else {
+					b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing(indicator+" · Tab to scroll notes")) + "\n")
+				}
+			} else {
+				b.WriteString(m.renderNotes() + "\n")
+			}
 		}
 		b.WriteString("\n")
 
 		// Display available actions with selection highlighting
 		for i, action := range m.actions {
-			if i == m.index {
+			// The pin action reads as unpin for a book that is already pinned
+			if action == "Pin to Top" && m.SelectedBook.Pinned {
+				action = "Unpin"
+			}
+			// Likewise the reading action clears the mark from the current book
+			if action == "Mark as Reading" && m.SelectedBook.Reading {
+				action = "Stop Reading"
+			}
+			// A wishlist book can be marked as owned once bought
+			if action == "Move to Wishlist" && !m.SelectedBook.Owned {
+				action = "Mark as Owned"
+			}
+			// A lent book is returned rather than lent again
+			if action == "Lend Book" && m.SelectedBook.LentTo != "" {
+				action = "Return Book"
+			}
+			if i == m.index && !m.notesFocused {
 				// Highlight currently selected action
 				b.WriteString(styles.SelectedStyle().Render(styles.AddLetterSpacing(action)))
 			} else {
@@ -203,6 +399,12 @@ func (m DetailModel) View() string {
 			}
 			b.WriteString("\n\n")
 		}
+
+		// Ask who the book is being lent to below the actions
+		if m.lending {
+			b.WriteString(styles.FocusedStyle().Render(styles.AddLetterSpacing("Lend to:")) + "\n")
+			b.WriteString(m.borrower.View() + "\n")
+		}
 	}
 
 	// Show success message if book was recently updated
@@ -212,6 +414,13 @@ func (m DetailModel) View() string {
 		b.WriteString("\n")
 	}
 
+	// Show the citation that was just copied
+	if m.copied != "" {
+		b.WriteString("\n")
+		b.WriteString(styles.SuccessStyle.Render(styles.AddLetterSpacing("Copied citation: " + m.copied)))
+		b.WriteString("\n")
+	}
+
 	// Show any error messages
 	if m.err != nil {
 		b.WriteString("\n")
@@ -220,7 +429,11 @@ func (m DetailModel) View() string {
 	}
 
 	// Display help text
-	b.WriteString("\n" + styles.HelpTextStyle.Render(styles.AddLetterSpacing("Use ↑/↓ or j/k to navigate, Enter to select, Esc to go back, q to quit")))
+	if m.lending {
+		b.WriteString("\n" + renderHelp(help.DetailLend))
+		return b.String()
+	}
+	b.WriteString("\n" + renderHelp(help.Detail))
 
 	return b.String()
 }
@@ -236,6 +449,141 @@ func (m *DetailModel) SetBook(book *models.Book) {
 	m.index = 0       // Reset to first action
 	m.err = nil       // Clear any previous errors
 	m.updated = false // Clear any previous update success message
+	m.copied = ""     // Clear any previous copied citation
+	m.lending = false // Drop any half-typed borrower's name
+	m.borrower.Blur()
+	m.authorCount = -1 // Counted again for the new book
+
+	// Start each book with the notes scrolled to the top and unfocused
+	m.notesFocused = false
+	m.syncNotes()
+	m.notes.GotoTop()
+}
+
+// SetSize records the terminal height so long notes can be sized to fit.
+// This is called by the root model whenever the terminal is resized.
+//
+// Parameters:
+//   - width: Terminal width in columns (unused; notes wrap at a fixed width)
+//   - height: Terminal height in lines
+func (m *DetailModel) SetSize(width, height int) {
+	m.height = height
+	m.syncNotes()
+}
+
+// renderNotes returns the notes wrapped and styled as they appear on screen.
+func (m DetailModel) renderNotes() string {
+	// Wrap long notes to fit terminal width and add quotation marks
+	wrappedNotes := utils.WrapText(m.SelectedBook.Notes, constants.TextWrapWidth)
+	return styles.SpacedNotesStyle.Render("\"" + styles.AddLetterSpacing(wrappedNotes) + "\"")
+}
+
+// notesHeight returns how many lines of notes fit on screen below the book details.
+func (m DetailModel) notesHeight() int {
+	if m.height == 0 {
+		return constants.NotesViewportHeight
+	}
+	return max(m.height-constants.DetailChromeHeight, constants.NotesViewportMinHeight)
+}
+
+// scrollableNotes reports whether the current notes are too tall to show inline.
+func (m DetailModel) scrollableNotes() bool {
+	if m.SelectedBook == nil || m.SelectedBook.Notes == "" {
+		return false
+	}
+	return lipgloss.Height(m.renderNotes()) > m.notesHeight()
+}
+
+// syncNotes sizes the notes viewport and loads the current notes into it.
+// The notes lose focus when they become short enough to show inline.
+func (m *DetailModel) syncNotes() {
+	if !m.scrollableNotes() {
+		m.notesFocused = false
+		return
+	}
+	content := m.renderNotes()
+	m.notes.Width = lipgloss.Width(content)
+	m.notes.Height = m.notesHeight()
+	m.notes.SetContent(content)
+}
+
+// SetBooks sets the list the detail screen steps through and shows the book at index.
+// The books point into the list screen's books, so edits made from the detail
+// screen are reflected in both places.
+//
+// Parameters:
+//   - books: Books in the current list order
+//   - index: Position of the book to display
+//
+// Returns:
+//   - tea.Cmd: Command that counts the books by the displayed book's author
+func (m *DetailModel) SetBooks(books []*models.Book, index int) tea.Cmd {
+	m.books = books
+	return m.showBook(index)
+}
+
+// Position returns the index of the displayed book within the list.
+// The list screen uses this to keep its selection on the last book viewed.
+func (m DetailModel) Position() int {
+	return m.position
+}
+
+// showBook displays the book at index within the current list,
+// resetting the action selection and any messages from the previous book.
+// It returns the command that counts the books by the new book's author.
+func (m *DetailModel) showBook(index int) tea.Cmd {
+	m.position = index
+	m.SetBook(m.books[index])
+	return m.countAuthorCmd()
+}
+
+// statsLine describes where the book sits, such as
+// "Book 5 of 42 · 3rd newest · 2 other books by this author".
+// The position and age need a list of more than one book, and the author
+// count needs a named author and a finished count; pieces that cannot be
+// worked out are left out, and an empty string means there is nothing to show.
+func (m DetailModel) statsLine() string {
+	var parts []string
+	if len(m.books) > 1 {
+		parts = append(parts, fmt.Sprintf("Book %d of %d", m.position+1, len(m.books)))
+		if m.SelectedBook != nil {
+			rank := 1
+			for _, book := range m.books {
+				if book.CreatedAt.After(m.SelectedBook.CreatedAt) {
+					rank++
+				}
+			}
+			if rank == 1 {
+				parts = append(parts, "Newest")
+			} else {
+				parts = append(parts, utils.FormatOrdinal(rank)+" newest")
+			}
+		}
+	}
+	if m.authorCount > 0 {
+		switch others := m.authorCount - 1; others {
+		case 0:
+			parts = append(parts, "No other books by this author")
+		case 1:
+			parts = append(parts, "1 other book by this author")
+		default:
+			parts = append(parts, fmt.Sprintf("%d other books by this author", others))
+		}
+	}
+	return strings.Join(parts, " · ")
+}
+
+// countAuthorCmd creates a command that counts the books by the selected book's author,
+// returning an AuthorCountMsg. It returns nil when there is no author to count.
+func (m DetailModel) countAuthorCmd() tea.Cmd {
+	if m.SelectedBook == nil || strings.TrimSpace(m.SelectedBook.Author) == "" {
+		return nil
+	}
+	id, author := m.SelectedBook.ID, m.SelectedBook.Author
+	return func() tea.Msg {
+		count, err := m.db.CountByAuthor(author)
+		return messages.AuthorCountMsg{BookID: id, Count: count, Err: err}
+	}
 }
 
 // deleteBookCmd creates a command that asynchronously deletes the currently selected book.
@@ -253,6 +601,116 @@ func (m DetailModel) deleteBookCmd() tea.Cmd {
 	}
 }
 
+// togglePinCmd creates a command that asynchronously pins or unpins the selected book.
+// It returns a PinMsg with the book's new pinned state.
+//
+// Returns:
+//   - tea.Cmd: Command that updates the pin and returns PinMsg
+func (m DetailModel) togglePinCmd() tea.Cmd {
+	id, pinned := m.SelectedBook.ID, !m.SelectedBook.Pinned
+	return func() tea.Msg {
+		err := m.db.SetPinned(id, pinned)
+		return messages.PinMsg{Pinned: pinned, Err: err}
+	}
+}
+
+// copyCitationCmd creates a command that copies a citation for the selected book
+// to the system clipboard. It returns a CitationMsg with the copied text.
+//
+// Parameters:
+//   - style: Citation style, utils.CitationAPA or utils.CitationMLA
+//
+// Returns:
+//   - tea.Cmd: Command that writes the clipboard and returns CitationMsg
+func (m DetailModel) copyCitationCmd(style string) tea.Cmd {
+	citation := utils.FormatCitation(*m.SelectedBook, style)
+	return func() tea.Msg {
+		if err := clipboard.WriteAll(citation); err != nil {
+			return messages.CitationMsg{Err: fmt.Errorf("could not copy to clipboard: %v", err)}
+		}
+		return messages.CitationMsg{Citation: citation}
+	}
+}
+
+// toggleReadingCmd creates a command that asynchronously marks or unmarks the selected
+// book as the one currently being read. It returns a ReadingMsg with the book's new state.
+//
+// Returns:
+//   - tea.Cmd: Command that updates the mark and returns ReadingMsg
+func (m DetailModel) toggleReadingCmd() tea.Cmd {
+	id, reading := m.SelectedBook.ID, !m.SelectedBook.Reading
+	return func() tea.Msg {
+		err := m.db.SetReading(id, reading)
+		return messages.ReadingMsg{Reading: reading, Err: err}
+	}
+}
+
+// toggleOwnedCmd creates a command that asynchronously marks the selected book as owned
+// or moves it to the wishlist. It returns an OwnedMsg with the book's new state.
+//
+// Returns:
+//   - tea.Cmd: Command that updates the book and returns OwnedMsg
+func (m DetailModel) toggleOwnedCmd() tea.Cmd {
+	id, owned := m.SelectedBook.ID, !m.SelectedBook.Owned
+	return func() tea.Msg {
+		err := m.db.SetOwned(id, owned)
+		return messages.OwnedMsg{Owned: owned, Err: err}
+	}
+}
+
+// lendBookCmd creates a command that asynchronously lends the selected book to borrower,
+// dated now. It returns a LendMsg with the book's new borrower and date.
+//
+// Returns:
+//   - tea.Cmd: Command that lends the book and returns LendMsg
+func (m DetailModel) lendBookCmd(borrower string) tea.Cmd {
+	id, borrower := m.SelectedBook.ID, strings.TrimSpace(borrower)
+	return func() tea.Msg {
+		if err := m.db.LendBook(id, borrower); err != nil {
+			return messages.LendMsg{Err: err}
+		}
+		now := time.Now()
+		return messages.LendMsg{LentTo: borrower, LentDate: &now}
+	}
+}
+
+// returnBookCmd creates a command that asynchronously records the selected book as returned,
+// clearing its borrower and date. It returns an empty LendMsg.
+//
+// Returns:
+//   - tea.Cmd: Command that returns the book and returns LendMsg
+func (m DetailModel) returnBookCmd() tea.Cmd {
+	id := m.SelectedBook.ID
+	return func() tea.Msg {
+		return messages.LendMsg{Err: m.db.ReturnBook(id)}
+	}
+}
+
+// NotesFocused reports whether keys scroll the long notes,
+// so the root model leaves PgUp/PgDn to the notes rather than the screen.
+func (m DetailModel) NotesFocused() bool {
+	return m.notesFocused
+}
+
+// EnteringBorrower reports whether the borrower's name is being typed,
+// so the root model does not treat q as quit.
+func (m DetailModel) EnteringBorrower() bool {
+	return m.lending
+}
+
+// backToListCmd returns the command to run when going back to the book list.
+// After a pin, reading, ownership or lending change the list is reloaded so it matches the database.
+//
+// Returns:
+//   - tea.Cmd: Command that reloads the books, or nil if nothing changed
+func (m *DetailModel) backToListCmd() tea.Cmd {
+	if !m.listChanged {
+		return nil
+	}
+	m.listChanged = false
+	return reloadBooksCmd
+}
+
 // ClearUpdated resets the updated flag to hide the success message.
 // This is typically called when navigating away from the detail screen
 // to ensure the success message doesn't persist across screen transitions.
@@ -260,17 +718,12 @@ func (m *DetailModel) ClearUpdated() {
 	m.updated = false
 }
 
-// loadBooksCmd creates a command that asynchronously reloads all books from the database.
-// This is used after successful book deletion to refresh the book list before returning to it.
-// It ensures the deleted book no longer appears in the list.
+// reloadBooksCmd asks the book list to reload its books before returning to it.
+// This is used after a successful deletion or change so the list matches the database,
+// and the list reloads the same subset it showed, such as the wishlist.
 //
 // Returns:
-//   - tea.Cmd: Command that loads books and returns LoadBooksMsg
-func (m DetailModel) loadBooksCmd() tea.Cmd {
-	return func() tea.Msg {
-		// Reload all books from database
-		books, err := m.db.LoadBooks()
-		// Return message containing the refreshed book list
-		return messages.LoadBooksMsg{Books: books, Err: err}
-	}
+//   - tea.Msg: ReloadBooksMsg for the book list
+func reloadBooksCmd() tea.Msg {
+	return messages.ReloadBooksMsg{}
 }
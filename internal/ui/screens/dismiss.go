@@ -0,0 +1,23 @@
+package screens
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/papadavis47/libros/internal/config"
+	"github.com/papadavis47/libros/internal/messages"
+	"github.com/papadavis47/libros/internal/models"
+)
+
+// dismissCmd returns a command that sends a DismissMsg for the success message
+// numbered seq on screen once the configured message timeout has passed.
+// It returns nil when messages are kept until the user moves on.
+func dismissCmd(screen models.Screen, seq int) tea.Cmd {
+	timeout := config.GetMessageTimeout()
+	if timeout == 0 {
+		return nil
+	}
+	return tea.Tick(timeout, func(time.Time) tea.Msg {
+		return messages.DismissMsg{Screen: screen, Seq: seq}
+	})
+}
@@ -0,0 +1,212 @@
+package screens
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/papadavis47/libros/internal/database"
+	"github.com/papadavis47/libros/internal/help"
+	"github.com/papadavis47/libros/internal/messages"
+	"github.com/papadavis47/libros/internal/models"
+	"github.com/papadavis47/libros/internal/styles"
+	"github.com/papadavis47/libros/internal/utils"
+)
+
+// DuplicatesScreen lists groups of books that share a title and author and
+// merges a chosen group into its oldest record after the user confirms.
+type DuplicatesScreen struct {
+	db         *database.DB
+	loading    bool
+	merging    bool
+	confirming bool
+	groups     [][]models.Book
+	index      int
+	status     string
+	statusErr  bool
+	err        error
+}
+
+func NewDuplicatesScreen(db *database.DB) *DuplicatesScreen {
+	return &DuplicatesScreen{db: db}
+}
+
+// Start clears any previous result and looks for duplicates.
+// It returns the command that loads the groups.
+func (s *DuplicatesScreen) Start() tea.Cmd {
+	s.status = ""
+	s.statusErr = false
+	return s.reload()
+}
+
+// reload looks for duplicates again, keeping any merge status on screen
+func (s *DuplicatesScreen) reload() tea.Cmd {
+	s.loading = true
+	s.merging = false
+	s.confirming = false
+	s.groups = nil
+	s.err = nil
+	return s.findDuplicatesCmd()
+}
+
+func (s *DuplicatesScreen) Init() tea.Cmd {
+	return nil
+}
+
+func (s *DuplicatesScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		// Ignore input while a merge is in progress
+		if s.merging {
+			return s, nil
+		}
+		if s.confirming {
+			switch msg.String() {
+			case "y":
+				s.confirming = false
+				s.merging = true
+				return s, s.mergeGroupCmd(s.groups[s.index])
+			case "n", "esc":
+				s.confirming = false
+			}
+			return s, nil
+		}
+		switch msg.String() {
+		case "up", "k":
+			if s.index > 0 {
+				s.index--
+			}
+		case "down", "j":
+			if s.index < len(s.groups)-1 {
+				s.index++
+			}
+		case "enter":
+			// Ask before merging the selected group
+			if len(s.groups) > 0 {
+				s.status = ""
+				s.confirming = true
+			}
+		case "esc":
+			// Return to utilities screen
+			return s, SwitchScreenCmd(models.UtilitiesScreen)
+		case "ctrl+c":
+			return s, tea.Quit
+		}
+
+	case messages.DuplicatesMsg:
+		s.loading = false
+		s.groups = msg.Groups
+		s.err = msg.Err
+		if s.index >= len(s.groups) {
+			s.index = max(len(s.groups)-1, 0)
+		}
+
+	case messages.MergeBooksMsg:
+		s.merging = false
+		if msg.Err != nil {
+			s.status = "Merge failed: " + msg.Err.Error()
+			s.statusErr = true
+			return s, nil
+		}
+		s.status = fmt.Sprintf("Merged %d duplicate record(s) into %q", msg.Merged, msg.Title)
+		s.statusErr = false
+		// Look again so the merged group drops out of the list
+		return s, s.reload()
+	}
+
+	return s, nil
+}
+
+func (s *DuplicatesScreen) View() string {
+	var b strings.Builder
+
+	b.WriteString("\n")
+	b.WriteString(styles.TitleStyle().Render(styles.Label("Ｆｉｎｄ　Ｄｕｐｌｉｃａｔｅｓ")))
+	b.WriteString("\n\n")
+
+	statusStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#00FF00")).
+		Bold(true).
+		Padding(1, 0).
+		PaddingLeft(3)
+	if s.status != "" {
+		if s.statusErr {
+			statusStyle = statusStyle.Foreground(lipgloss.Color("#FF0000"))
+		}
+		b.WriteString(statusStyle.Render(styles.AddLetterSpacing(s.status)))
+		b.WriteString("\n")
+	}
+
+	switch {
+	case s.loading:
+		b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing("Looking for duplicates...")))
+		b.WriteString("\n")
+	case s.err != nil:
+		b.WriteString(styles.ErrorStyle.Render(styles.AddLetterSpacing("Error: " + s.err.Error())))
+		b.WriteString("\n")
+	case len(s.groups) == 0:
+		b.WriteString(styles.SuccessStyle.Render(styles.AddLetterSpacing("No duplicate books found")))
+		b.WriteString("\n")
+	default:
+		for i, group := range s.groups {
+			heading := fmt.Sprintf("%s by %s (%d records)", group[0].Title, group[0].Author, len(group))
+			if i == s.index {
+				b.WriteString(styles.SelectedStyle().Render(styles.AddLetterSpacing(heading)))
+			} else {
+				b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing(heading)))
+			}
+			b.WriteString("\n")
+			// List each record so the user can see what will be merged
+			for _, book := range group {
+				notes := "no notes"
+				if strings.TrimSpace(book.Notes) != "" {
+					notes = "has notes"
+				}
+				line := fmt.Sprintf("    #%d · added %s · %s", book.ID, utils.FormatDate(book.CreatedAt), notes)
+				b.WriteString(styles.HelpTextStyle.Render(line))
+				b.WriteString("\n")
+			}
+			b.WriteString("\n")
+		}
+	}
+
+	switch {
+	case s.merging:
+		b.WriteString("\n" + styles.BlurredStyle.Render(styles.AddLetterSpacing("Merging...")))
+	case s.confirming:
+		group := s.groups[s.index]
+		prompt := fmt.Sprintf("Merge %d records of %q into #%d? Press y to merge, n or Esc to cancel", len(group), group[0].Title, group[0].ID)
+		b.WriteString("\n" + styles.ErrorStyle.Render(styles.AddLetterSpacing(prompt)))
+	default:
+		b.WriteString("\n" + renderHelp(help.Duplicates))
+	}
+
+	return b.String()
+}
+
+// findDuplicatesCmd loads every book and groups the duplicates asynchronously,
+// reporting the result as a DuplicatesMsg.
+func (s *DuplicatesScreen) findDuplicatesCmd() tea.Cmd {
+	return func() tea.Msg {
+		books, err := s.db.LoadBooks()
+		if err != nil {
+			return messages.DuplicatesMsg{Err: err}
+		}
+		return messages.DuplicatesMsg{Groups: models.DuplicateGroups(books)}
+	}
+}
+
+// mergeGroupCmd merges a group into its oldest record asynchronously,
+// reporting the result as a MergeBooksMsg.
+func (s *DuplicatesScreen) mergeGroupCmd(group []models.Book) tea.Cmd {
+	keep := group[0]
+	mergeIDs := make([]int, 0, len(group)-1)
+	for _, book := range group[1:] {
+		mergeIDs = append(mergeIDs, book.ID)
+	}
+	return func() tea.Msg {
+		err := s.db.MergeBooks(keep.ID, mergeIDs)
+		return messages.MergeBooksMsg{Title: keep.Title, Merged: len(mergeIDs), Err: err}
+	}
+}
@@ -10,8 +10,11 @@ import (
 	"github.com/charmbracelet/bubbles/textarea"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/papadavis47/libros/internal/config"
 	"github.com/papadavis47/libros/internal/database"
 	"github.com/papadavis47/libros/internal/factory"
+	"github.com/papadavis47/libros/internal/help"
+	"github.com/papadavis47/libros/internal/i18n"
 	"github.com/papadavis47/libros/internal/messages"
 	"github.com/papadavis47/libros/internal/models"
 	"github.com/papadavis47/libros/internal/styles"
@@ -23,12 +26,13 @@ import (
 type EditModel struct {
 	db           *database.DB      // Database connection for saving changes
 	SelectedBook *models.Book      // Book being edited (set by navigation from detail screen)
-	inputs       []textinput.Model // Text input fields for title and author
+	inputs       []textinput.Model // Text input fields for title, author and location
 	textarea     textarea.Model    // Multi-line text area for notes
 	bookTypes    []models.BookType // Available book types (Paperback, Hardback, etc.)
 	selectedType int               // Currently selected book type index
-	focused      int               // Currently focused form element (0=title, 1=author, 2=type, 3=notes, 4=button)
+	focused      int               // Currently focused form element (0=title, 1=author, 2=location, 3=type, 4=notes, 5=button)
 	err          error             // Any error from form validation or save operation
+	duplicate    bool              // Another book has the same title and author; next save proceeds anyway
 }
 
 // NewEditModel creates and initializes a new EditModel instance.
@@ -43,7 +47,7 @@ type EditModel struct {
 func NewEditModel(db *database.DB) EditModel {
 	m := EditModel{
 		db:     db,
-		inputs: make([]textinput.Model, 2), // Title and Author inputs
+		inputs: make([]textinput.Model, 3), // Title, Author and Location inputs
 		// Define available book types in order
 		bookTypes:    []models.BookType{models.Paperback, models.Hardback, models.Audio, models.Digital},
 		selectedType: 0, // Start with first book type selected
@@ -53,6 +57,7 @@ func NewEditModel(db *database.DB) EditModel {
 	// Initialize text inputs using factory functions
 	m.inputs[0] = factory.CreateTitleInput()
 	m.inputs[1] = factory.CreateAuthorInput()
+	m.inputs[2] = factory.CreateLocationInput()
 
 	// Initialize textarea using factory function
 	m.textarea = factory.CreateNotesTextArea()
@@ -64,7 +69,7 @@ func NewEditModel(db *database.DB) EditModel {
 // It manages focus navigation between form fields, handles book type selection,
 // processes form submission, and responds to save operations from the database.
 //
-// The focus order is: Title -> Author -> Book Type -> Notes -> Save Button
+// The focus order is: Title -> Author -> Location -> Book Type -> Notes -> Save Button
 //
 // Parameters:
 //   - msg: Message to process (keyboard input or system message)
@@ -76,6 +81,19 @@ func NewEditModel(db *database.DB) EditModel {
 func (m EditModel) Update(msg tea.Msg) (EditModel, tea.Cmd, models.Screen) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		// Any key other than confirming the save dismisses the duplicate warning
+		if msg.String() != "enter" {
+			m.duplicate = false
+		}
+
+		// Single-letter shortcuts pick a type, but only while the type selector is focused
+		if m.focused == len(m.inputs) {
+			if index, ok := typeIndexForKey(m.bookTypes, msg.String()); ok {
+				m.selectedType = index
+				return m, nil, models.EditBookScreen
+			}
+		}
+
 		switch msg.String() {
 		case "esc": // Cancel editing and return to detail screen
 			m.err = nil // Clear any errors
@@ -90,12 +108,37 @@ func (m EditModel) Update(msg tea.Msg) (EditModel, tea.Cmd, models.Screen) {
 				m.inputs[m.focused].CursorEnd()
 			}
 			return m, nil, models.EditBookScreen
+		case "ctrl+u": // Clear the focused text input or notes field, leaving the others as they are
+			if m.focused < len(m.inputs) {
+				m.inputs[m.focused].SetValue("")
+			} else if m.focused == len(m.inputs)+1 {
+				m.textarea.SetValue("")
+			}
+			return m, nil, models.EditBookScreen
+		case "ctrl+up", "ctrl+down": // Shrink or grow the notes field while it is focused
+			if m.focused == len(m.inputs)+1 {
+				m.err = resizeNotes(&m.textarea, msg.String() == "ctrl+down")
+			}
+			return m, nil, models.EditBookScreen
 		case "tab", "shift+tab", "enter", "up", "down":
 			s := msg.String()
 
+			// Tab in the author field accepts the current autocomplete suggestion
+			if s == "tab" && m.focused == 1 {
+				var cmd tea.Cmd
+				m.inputs[1], cmd = m.inputs[1].Update(msg)
+				return m, cmd, models.EditBookScreen
+			}
+
 			// Handle form submission when save button is focused
 			if s == "enter" && m.focused == len(m.inputs)+2 {
-				return m, m.updateBookCmd(), models.EditBookScreen
+				// A second Enter after the duplicate warning saves anyway
+				if m.duplicate {
+					m.duplicate = false
+					return m, m.updateBookCmd(), models.EditBookScreen
+				}
+				// Otherwise check for a matching book before saving
+				return m, m.checkDuplicateCmd(), models.EditBookScreen
 			}
 
 			// Handle tab within type field to cycle through book types
@@ -182,6 +225,26 @@ func (m EditModel) Update(msg tea.Msg) (EditModel, tea.Cmd, models.Screen) {
 			// This will be handled by updateInputs() method
 		}
 
+	case messages.AuthorsMsg:
+		// Suggestions are optional, so a failed load simply leaves them empty
+		if msg.Err == nil {
+			m.inputs[1].SetSuggestions(msg.Authors)
+		}
+		return m, nil, models.EditBookScreen
+
+	case messages.DuplicateCheckMsg: // Handle duplicate check before saving
+		if msg.Err != nil {
+			m.err = msg.Err
+			return m, nil, models.EditBookScreen
+		}
+		if msg.Exists {
+			// Warn and wait for the user to confirm or change the fields
+			m.duplicate = true
+			return m, nil, models.EditBookScreen
+		}
+		// No duplicate found - proceed with the update
+		return m, m.updateBookCmd(), models.EditBookScreen
+
 	case messages.UpdateMsg: // Handle save operation result
 		if msg.Err != nil {
 			// Store error for display
@@ -192,6 +255,7 @@ func (m EditModel) Update(msg tea.Msg) (EditModel, tea.Cmd, models.Screen) {
 			m.SelectedBook.Author = m.inputs[1].Value()
 			m.SelectedBook.Type = m.bookTypes[m.selectedType]
 			m.SelectedBook.Notes = m.textarea.Value()
+			m.SelectedBook.Location = strings.TrimSpace(m.inputs[2].Value())
 			return m, nil, models.BookDetailScreen
 		}
 	}
@@ -239,12 +303,12 @@ func (m EditModel) View() string {
 
 	// Display application title and screen subtitle
 	b.WriteString("\n")
-	b.WriteString(styles.TitleStyle().Render("Ｌｉｂｒｏｓ　－　Ａ　Ｂｏｏｋ　Ｍａｎａｇｅｒ"))
+	b.WriteString(styles.TitleStyle().Render(styles.Label(i18n.T(i18n.AppTitle))))
 	b.WriteString("\n\n")
-	b.WriteString(styles.BlurredStyle.Render("Ｅｄｉｔ　Ｂｏｏｋ"))
+	b.WriteString(styles.BlurredStyle.Render(styles.Label(i18n.T(i18n.FormEditTitle))))
 	b.WriteString("\n\n")
 
-	// Render all text input fields (title and author)
+	// Render all text input fields (title, author and location)
 	for i := range m.inputs {
 		b.WriteString(m.inputs[i].View())
 		if i == 0 {
@@ -256,7 +320,7 @@ func (m EditModel) View() string {
 
 	// Add book type selector with focus-aware styling
 	b.WriteString("\n")
-	typeLabel := "   " + styles.AddLetterSpacing("Type:") + "  "
+	typeLabel := "   " + styles.AddLetterSpacing(i18n.T(i18n.FormType)) + "  "
 	if m.focused == len(m.inputs) {
 		// Book type selector is focused
 		b.WriteString(styles.FormFocusedStyle().Render(typeLabel))
@@ -267,7 +331,7 @@ func (m EditModel) View() string {
 
 	// Render each book type option with appropriate styling
 	for i, bookType := range m.bookTypes {
-		buttonText := fmt.Sprintf("  %s  ", styles.AddLetterSpacing(styles.CapitalizeBookType(string(bookType))))
+		buttonText := fmt.Sprintf("  %s  ", styles.AddLetterSpacing(typeName(bookType)))
 		if i == m.selectedType {
 			// This is the currently selected book type
 			if m.focused == len(m.inputs) {
@@ -290,26 +354,38 @@ func (m EditModel) View() string {
 
 	// Add notes textarea with label
 	b.WriteString("\n")
-	b.WriteString(styles.FocusedStyle().Render(styles.AddLetterSpacing("Notes:") + " "))
+	b.WriteString(styles.FocusedStyle().Render(styles.AddLetterSpacing(i18n.T(i18n.FormNotes)) + " "))
 	b.WriteString("\n\n")
 	b.WriteString(m.textarea.View())
 
 	// Add save button with focus-aware styling
 	if m.focused == len(m.inputs)+2 {
 		// Save button is focused
-		fmt.Fprintf(&b, "\n\n%s\n\n", styles.ButtonStyle().Render(styles.AddLetterSpacing("UPDATE BOOK")))
+		fmt.Fprintf(&b, "\n\n%s\n\n", styles.ButtonStyle().Render(styles.AddLetterSpacing(i18n.T(i18n.FormUpdate))))
 	} else {
-		fmt.Fprintf(&b, "\n\n%s\n\n", styles.BlurredStyle.Render(styles.AddLetterSpacing("UPDATE BOOK")))
+		fmt.Fprintf(&b, "\n\n%s\n\n", styles.BlurredStyle.Render(styles.AddLetterSpacing(i18n.T(i18n.FormUpdate))))
 	}
 
 	// Show any validation or save errors
 	if m.err != nil {
-		b.WriteString(styles.ErrorStyle.Render(styles.AddLetterSpacing("Error: " + m.err.Error())))
+		b.WriteString(styles.ErrorStyle.Render(styles.AddLetterSpacing(fmt.Sprintf(i18n.T(i18n.FormError), m.err))))
+		b.WriteString("\n")
+	}
+
+	// Warn when the edit would duplicate another book's title and author
+	if m.duplicate {
+		b.WriteString(styles.ErrorStyle.Render(styles.AddLetterSpacing(i18n.T(i18n.FormDuplicate))))
 		b.WriteString("\n")
+		b.WriteString(styles.ErrorStyle.Render(styles.AddLetterSpacing(i18n.T(i18n.FormDuplicateConfirm))))
+		b.WriteString("\n\n")
 	}
 
 	// Display help text
-	b.WriteString(styles.HelpTextStyle.Render(styles.AddLetterSpacing("Press Esc to cancel, Ctrl+A/Ctrl+E for start/end of field, q or Ctrl+C to quit")))
+	if m.focused == len(m.inputs) {
+		b.WriteString(renderHelp(help.EditType))
+	} else {
+		b.WriteString(renderHelp(help.Edit))
+	}
 
 	return b.String()
 }
@@ -322,13 +398,16 @@ func (m EditModel) View() string {
 //   - book: Pointer to the book to edit
 func (m *EditModel) SetBook(book *models.Book) {
 	m.SelectedBook = book
-	m.focused = 0 // Start with title field focused
-	m.err = nil   // Clear any previous errors
+	m.focused = 0       // Start with title field focused
+	m.err = nil         // Clear any previous errors
+	m.duplicate = false // Clear any previous duplicate warning
 
 	// Populate text input fields with current book data
 	m.inputs[0].SetValue(book.Title)
 	m.inputs[1].SetValue(book.Author)
+	m.inputs[2].SetValue(book.Location)
 	m.textarea.SetValue(book.Notes)
+	m.textarea.SetHeight(config.GetNotesHeight()) // Sized as last chosen on either form
 
 	// Find and select the current book type in the selector
 	for i, bookType := range m.bookTypes {
@@ -347,6 +426,15 @@ func (m *EditModel) SetBook(book *models.Book) {
 	m.textarea.Blur() // Ensure textarea is not focused
 }
 
+// LoadAuthorsCmd creates a command that loads existing authors for autocomplete.
+// It is run once when the edit screen opens rather than on each keystroke.
+//
+// Returns:
+//   - tea.Cmd: Command that loads authors and returns AuthorsMsg
+func (m EditModel) LoadAuthorsCmd() tea.Cmd {
+	return loadAuthorsCmd(m.db)
+}
+
 // updateBookCmd creates a command that asynchronously saves the edited book to the database.
 // It collects all form values and calls the database update method with the current book's ID.
 // The command returns an UpdateMsg with the result (success or error).
@@ -360,11 +448,25 @@ func (m EditModel) updateBookCmd() tea.Cmd {
 		author := m.inputs[1].Value()           // Author from second input
 		bookType := m.bookTypes[m.selectedType] // Selected book type
 		notes := m.textarea.Value()             // Notes from textarea
+		location := m.inputs[2].Value()         // Location from third input
 
 		// Update the book in the database
-		err := m.db.UpdateBook(m.SelectedBook.ID, title, author, bookType, notes)
+		err := m.db.UpdateBook(m.SelectedBook.ID, title, author, bookType, notes, location)
 
 		// Return message containing the result
 		return messages.UpdateMsg{Err: err}
 	}
 }
+
+// checkDuplicateCmd creates a command that checks whether another book already uses
+// the entered title and author. The book being edited is excluded so saving without
+// renaming it never triggers a false warning.
+//
+// Returns:
+//   - tea.Cmd: Command that performs the check and returns DuplicateCheckMsg
+func (m EditModel) checkDuplicateCmd() tea.Cmd {
+	return func() tea.Msg {
+		exists, err := m.db.DuplicateExists(m.inputs[0].Value(), m.inputs[1].Value(), m.SelectedBook.ID)
+		return messages.DuplicateCheckMsg{Exists: exists, Err: err}
+	}
+}
@@ -1,21 +1,28 @@
 package screens
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/papadavis47/libros/internal/config"
 	"github.com/papadavis47/libros/internal/constants"
 	"github.com/papadavis47/libros/internal/database"
 	"github.com/papadavis47/libros/internal/factory"
+	"github.com/papadavis47/libros/internal/help"
 	"github.com/papadavis47/libros/internal/messages"
 	"github.com/papadavis47/libros/internal/models"
 	"github.com/papadavis47/libros/internal/services"
+	"github.com/papadavis47/libros/internal/services/browser"
 	"github.com/papadavis47/libros/internal/styles"
+	"github.com/papadavis47/libros/internal/utils"
+	"github.com/papadavis47/libros/internal/validation"
 )
 
 type SwitchScreenMsg struct {
@@ -33,7 +40,10 @@ type ExportState int
 
 const (
 	PathInput ExportState = iota // Getting file path input from user
-	FormatSelection              // Selecting export format (JSON/Markdown)
+	DateRangeInput               // Getting optional start/end dates to filter exported books
+	FormatSelection              // Selecting export format (JSON/Markdown/Text)
+	FieldSelection               // Choosing the optional fields of a JSON or JSON Lines export
+	PassphraseInput              // Getting and confirming the passphrase of an encrypted export
 	Exporting                    // Currently performing export
 	ShowResult                   // Showing export result (success/error)
 )
@@ -49,6 +59,29 @@ type ExportScreen struct {
 	isError           bool
 	defaultExportsDir string
 	lastExportedFile  string
+	folderNote        string          // Result of opening the export folder, shown under the export result
+	startInput        textinput.Model // Optional start date (YYYY-MM-DD) for the export range
+	endInput          textinput.Model // Optional end date (YYYY-MM-DD) for the export range
+	dateFocus         int             // Focused date input (0=start, 1=end)
+	rangeStart        time.Time       // Parsed start date, zero when open
+	rangeEnd          time.Time       // Parsed end date, zero when open
+	checksum          bool            // Whether to write a .sha256 manifest next to each export
+	fieldFormat       string          // JSON format ("json" or "jsonl") waiting on the field selection
+	fieldIndex        int             // Highlighted row of the field selection
+	fieldIncluded     map[string]bool // Optional fields to include in the JSON export
+	passphraseInput   textinput.Model // Passphrase for an encrypted JSON export
+	confirmInput      textinput.Model // The passphrase typed again, to catch typos
+	passphraseFocus   int             // Focused passphrase input (0=passphrase, 1=confirm)
+}
+
+// exportFieldLabels describes each optional JSON export field on the field selection
+var exportFieldLabels = map[string]string{
+	services.FieldAuthor:   "Author",
+	services.FieldType:     "Type",
+	services.FieldNotes:    "Notes",
+	services.FieldLocation: "Location",
+	services.FieldStatus:   "Status (pinned, reading, owned, lent)",
+	services.FieldDates:    "Dates (added, updated)",
 }
 
 func NewExportScreen(db *database.DB) *ExportScreen {
@@ -62,11 +95,25 @@ func NewExportScreen(db *database.DB) *ExportScreen {
 
 	formatItems := []string{
 		"ＪＳＯＮ　Ｆｏｒｍａｔ",
+		"ＪＳＯＮ　Ｌｉｎｅｓ　Ｆｏｒｍａｔ",
+		"Ｅｎｃｒｙｐｔｅｄ　ＪＳＯＮ",
 		"Ｍａｒｋｄｏｗｎ　Ｆｏｒｍａｔ",
+		"Ｍａｒｋｄｏｗｎ　ｗｉｔｈ　Ｃｏｎｔｅｎｔｓ",
+		"Ｏｎｅ　Ｆｉｌｅ　ｐｅｒ　Ｂｏｏｋ",
+		"Ｔｅｘｔ　Ｆｏｒｍａｔ",
+		"Ｒｅａｄｉｎｇ　Ｔｉｍｅｌｉｎｅ",
+		"Ｒｅａｄｉｎｇ　Ｊｏｕｒｎａｌ",
+		"Ｃａｌｉｂｒｅ　ＣＳＶ",
+		"Ｇｏｏｄｒｅａｄｓ　ＣＳＶ",
+		"Ｓｔａｔｉｓｔｉｃｓ　ＪＳＯＮ",
 		"Ｂａｃｋ　ｔｏ　Ｕｔｉｌｉｔｉｅｓ",
 		"Ｂａｃｋ　ｔｏ　Ｍａｉｎ　Ｍｅｎｕ",
 	}
 
+	// Initialize date range inputs; both are optional
+	startInput := factory.CreateTextInput("YYYY-MM-DD", len(validation.DateInputLayout))
+	endInput := factory.CreateTextInput("YYYY-MM-DD", len(validation.DateInputLayout))
+
 	return &ExportScreen{
 		db:                db,
 		state:             PathInput,
@@ -74,6 +121,10 @@ func NewExportScreen(db *database.DB) *ExportScreen {
 		formatItems:       formatItems,
 		formatIndex:       0,
 		defaultExportsDir: defaultExportsDir,
+		startInput:        startInput,
+		endInput:          endInput,
+		passphraseInput:   factory.CreatePassphraseInput("Passphrase"),
+		confirmInput:      factory.CreatePassphraseInput("Passphrase again"),
 	}
 }
 
@@ -86,6 +137,22 @@ func (s *ExportScreen) ClearStatus() {
 	s.pathInput.Focus()
 	s.formatIndex = 0
 	s.lastExportedFile = ""
+	s.folderNote = ""
+	s.startInput.SetValue("")
+	s.endInput.SetValue("")
+	s.startInput.Blur()
+	s.endInput.Blur()
+	s.dateFocus = 0
+	s.rangeStart = time.Time{}
+	s.rangeEnd = time.Time{}
+	s.checksum = false
+	s.clearPassphrase()
+}
+
+// EnteringPassphrase reports whether a passphrase is being typed, so global
+// keys such as q can be left to the passphrase inputs
+func (s *ExportScreen) EnteringPassphrase() bool {
+	return s.state == PassphraseInput
 }
 
 func (s *ExportScreen) Init() tea.Cmd {
@@ -96,8 +163,14 @@ func (s *ExportScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch s.state {
 	case PathInput:
 		return s.updatePathInput(msg)
+	case DateRangeInput:
+		return s.updateDateRangeInput(msg)
 	case FormatSelection:
 		return s.updateFormatSelection(msg)
+	case FieldSelection:
+		return s.updateFieldSelection(msg)
+	case PassphraseInput:
+		return s.updatePassphraseInput(msg)
 	case Exporting:
 		return s.updateExporting(msg)
 	case ShowResult:
@@ -147,11 +220,15 @@ func (s *ExportScreen) updatePathInput(msg tea.Msg) (tea.Model, tea.Cmd) {
 				s.exportPath = inputPath
 			}
 			
-			// Move to format selection
-			s.state = FormatSelection
+			// Move to the optional date range step
+			s.state = DateRangeInput
 			s.status = ""
 			s.isError = false
-			return s, nil
+			s.pathInput.Blur()
+			s.dateFocus = 0
+			s.startInput.Focus()
+			s.endInput.Blur()
+			return s, textinput.Blink
 			
 		case "esc":
 			return s, SwitchScreenCmd(models.UtilitiesScreen)
@@ -165,6 +242,102 @@ func (s *ExportScreen) updatePathInput(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return s, cmd
 }
 
+// updateDateRangeInput handles the optional start/end date step of the export flow
+// Leaving a date blank keeps that side of the range open (all-time before/after)
+func (s *ExportScreen) updateDateRangeInput(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "tab", "shift+tab", "up", "down":
+			// Toggle focus between the start and end inputs
+			s.setDateFocus(1 - s.dateFocus)
+			return s, textinput.Blink
+		case "enter":
+			// Enter on the start date moves on to the end date
+			if s.dateFocus == 0 {
+				s.setDateFocus(1)
+				return s, textinput.Blink
+			}
+
+			start, err := validation.ParseDateInput(s.startInput.Value())
+			if err != nil {
+				s.status = "Start date: " + err.Error()
+				s.isError = true
+				return s, nil
+			}
+			end, err := validation.ParseDateInput(s.endInput.Value())
+			if err != nil {
+				s.status = "End date: " + err.Error()
+				s.isError = true
+				return s, nil
+			}
+			if err := validation.ValidateDateRange(start, end); err != nil {
+				s.status = err.Error()
+				s.isError = true
+				return s, nil
+			}
+
+			// Move to format selection with the validated range
+			s.rangeStart = start
+			s.rangeEnd = end
+			s.state = FormatSelection
+			s.status = ""
+			s.isError = false
+			s.startInput.Blur()
+			s.endInput.Blur()
+			return s, nil
+		case "esc":
+			// Go back to path input
+			s.state = PathInput
+			s.status = ""
+			s.isError = false
+			s.startInput.Blur()
+			s.endInput.Blur()
+			s.pathInput.Prompt = "   " // Ensure proper alignment
+			s.pathInput.Focus()
+			return s, textinput.Blink
+		case "ctrl+c":
+			return s, tea.Quit
+		}
+	}
+
+	// Update whichever date input is focused
+	if s.dateFocus == 0 {
+		s.startInput, cmd = s.startInput.Update(msg)
+	} else {
+		s.endInput, cmd = s.endInput.Update(msg)
+	}
+	return s, cmd
+}
+
+// setDateFocus focuses the start (0) or end (1) date input and blurs the other
+func (s *ExportScreen) setDateFocus(index int) {
+	s.dateFocus = index
+	if index == 0 {
+		s.startInput.Focus()
+		s.endInput.Blur()
+	} else {
+		s.startInput.Blur()
+		s.endInput.Focus()
+	}
+}
+
+// describeDateRange returns a human-readable summary of the selected export range
+func (s *ExportScreen) describeDateRange() string {
+	switch {
+	case s.rangeStart.IsZero() && s.rangeEnd.IsZero():
+		return "All time"
+	case s.rangeEnd.IsZero():
+		return "From " + utils.FormatDate(s.rangeStart)
+	case s.rangeStart.IsZero():
+		return "Through " + utils.FormatDate(s.rangeEnd)
+	default:
+		return utils.FormatDate(s.rangeStart) + " to " + utils.FormatDate(s.rangeEnd)
+	}
+}
+
 func (s *ExportScreen) updateFormatSelection(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
@@ -177,31 +350,93 @@ func (s *ExportScreen) updateFormatSelection(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if s.formatIndex < len(s.formatItems)-1 {
 				s.formatIndex++
 			}
+		case "c": // Toggle writing a checksum manifest with the export
+			s.checksum = !s.checksum
 		case "enter":
 			selectedItem := s.formatItems[s.formatIndex]
 			switch selectedItem {
 			case "ＪＳＯＮ　Ｆｏｒｍａｔ":
-				s.state = Exporting
-				s.status = "Exporting to JSON..."
+				// Choose the fields to include before exporting
+				s.startFieldSelection("json")
+				return s, nil
+			case "ＪＳＯＮ　Ｌｉｎｅｓ　Ｆｏｒｍａｔ":
+				// Choose the fields to include before exporting
+				s.startFieldSelection("jsonl")
+				return s, nil
+			case "Ｅｎｃｒｙｐｔｅｄ　ＪＳＯＮ":
+				// Ask for the passphrase before exporting
+				s.state = PassphraseInput
+				s.status = ""
 				s.isError = false
-				s.lastExportedFile = filepath.Join(s.exportPath, "books.json")
-				return s, s.performExport("json")
+				s.clearPassphrase()
+				s.passphraseInput.Focus()
+				return s, textinput.Blink
 			case "Ｍａｒｋｄｏｗｎ　Ｆｏｒｍａｔ":
 				s.state = Exporting
 				s.status = "Exporting to Markdown..."
 				s.isError = false
 				s.lastExportedFile = filepath.Join(s.exportPath, "books.md")
 				return s, s.performExport("markdown")
+			case "Ｍａｒｋｄｏｗｎ　ｗｉｔｈ　Ｃｏｎｔｅｎｔｓ":
+				s.state = Exporting
+				s.status = "Exporting to Markdown with contents..."
+				s.isError = false
+				s.lastExportedFile = filepath.Join(s.exportPath, "books-contents.md")
+				return s, s.performExport("markdown-toc")
+			case "Ｏｎｅ　Ｆｉｌｅ　ｐｅｒ　Ｂｏｏｋ":
+				s.state = Exporting
+				s.status = "Exporting one Markdown file per book..."
+				s.isError = false
+				// A folder of its own keeps book pages apart from other exports
+				s.lastExportedFile = filepath.Join(s.exportPath, "books")
+				return s, s.performExport("per-book")
+			case "Ｔｅｘｔ　Ｆｏｒｍａｔ":
+				s.state = Exporting
+				s.status = "Exporting to plain text..."
+				s.isError = false
+				s.lastExportedFile = filepath.Join(s.exportPath, "books.txt")
+				return s, s.performExport("text")
+			case "Ｒｅａｄｉｎｇ　Ｔｉｍｅｌｉｎｅ":
+				s.state = Exporting
+				s.status = "Exporting reading timeline..."
+				s.isError = false
+				s.lastExportedFile = filepath.Join(s.exportPath, "books-timeline.md")
+				return s, s.performExport("timeline")
+			case "Ｒｅａｄｉｎｇ　Ｊｏｕｒｎａｌ":
+				s.state = Exporting
+				s.status = "Exporting reading journal..."
+				s.isError = false
+				s.lastExportedFile = filepath.Join(s.exportPath, "books-journal.md")
+				return s, s.performExport("journal")
+			case "Ｃａｌｉｂｒｅ　ＣＳＶ":
+				s.state = Exporting
+				s.status = "Exporting to Calibre CSV..."
+				s.isError = false
+				s.lastExportedFile = filepath.Join(s.exportPath, "books-calibre.csv")
+				return s, s.performExport("calibre")
+			case "Ｇｏｏｄｒｅａｄｓ　ＣＳＶ":
+				s.state = Exporting
+				s.status = "Exporting to Goodreads CSV..."
+				s.isError = false
+				s.lastExportedFile = filepath.Join(s.exportPath, "books-goodreads.csv")
+				return s, s.performExport("goodreads")
+			case "Ｓｔａｔｉｓｔｉｃｓ　ＪＳＯＮ":
+				s.state = Exporting
+				s.status = "Exporting statistics..."
+				s.isError = false
+				s.lastExportedFile = filepath.Join(s.exportPath, "stats.json")
+				return s, s.performExport("stats")
 			case "Ｂａｃｋ　ｔｏ　Ｕｔｉｌｉｔｉｅｓ":
 				return s, SwitchScreenCmd(models.UtilitiesScreen)
 			case "Ｂａｃｋ　ｔｏ　Ｍａｉｎ　Ｍｅｎｕ":
 				return s, SwitchScreenCmd(models.MenuScreen)
 			}
 		case "esc":
-			// Go back to path input
-			s.state = PathInput
-			s.pathInput.Prompt = "   " // Ensure proper alignment
-			s.pathInput.Focus()
+			// Go back to the date range step
+			s.state = DateRangeInput
+			s.dateFocus = 0
+			s.startInput.Focus()
+			s.endInput.Blur()
 			return s, textinput.Blink
 		case "q", "ctrl+c":
 			return s, tea.Quit
@@ -210,6 +445,153 @@ func (s *ExportScreen) updateFormatSelection(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return s, nil
 }
 
+// startFieldSelection opens the field checklist for a JSON export, starting from
+// the fields the last JSON export used
+func (s *ExportScreen) startFieldSelection(format string) {
+	s.fieldFormat = format
+	s.fieldIndex = 0
+	s.fieldIncluded = make(map[string]bool, len(services.ExportFields))
+	for _, field := range services.ExportFields {
+		s.fieldIncluded[field] = true
+	}
+	for _, field := range config.GetExportExclude() {
+		delete(s.fieldIncluded, field)
+	}
+	s.state = FieldSelection
+}
+
+// updateFieldSelection handles the optional field checklist of a JSON or JSON Lines export
+// The title is always included; the chosen fields are remembered for the next export
+func (s *ExportScreen) updateFieldSelection(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "up", "k":
+			if s.fieldIndex > 0 {
+				s.fieldIndex--
+			}
+		case "down", "j":
+			if s.fieldIndex < len(services.ExportFields)-1 {
+				s.fieldIndex++
+			}
+		case " ", "x": // Include or leave out the highlighted field
+			field := services.ExportFields[s.fieldIndex]
+			s.fieldIncluded[field] = !s.fieldIncluded[field]
+		case "enter":
+			var fields, excluded []string
+			for _, field := range services.ExportFields {
+				if s.fieldIncluded[field] {
+					fields = append(fields, field)
+				} else {
+					excluded = append(excluded, field)
+				}
+			}
+			// Failing to save only means the next export starts with the previous fields
+			_ = config.UpdateExportExclude(excluded)
+
+			s.state = Exporting
+			s.isError = false
+			if s.fieldFormat == "jsonl" {
+				s.status = "Exporting to JSON Lines..."
+				s.lastExportedFile = filepath.Join(s.exportPath, "books.jsonl")
+			} else {
+				s.status = "Exporting to JSON..."
+				s.lastExportedFile = filepath.Join(s.exportPath, "books.json")
+			}
+			return s, s.performExport(s.fieldFormat, fields...)
+		case "esc":
+			// Go back to format selection
+			s.state = FormatSelection
+			return s, nil
+		case "q", "ctrl+c":
+			return s, tea.Quit
+		}
+	}
+	return s, nil
+}
+
+// updatePassphraseInput handles the passphrase step of an encrypted JSON export
+// The passphrase is typed twice, and both inputs are cleared once the export starts
+func (s *ExportScreen) updatePassphraseInput(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "tab", "shift+tab", "up", "down":
+			// Toggle focus between the passphrase and its confirmation
+			s.setPassphraseFocus(1 - s.passphraseFocus)
+			return s, textinput.Blink
+		case "enter":
+			// Enter on the passphrase moves on to the confirmation
+			if s.passphraseFocus == 0 {
+				s.setPassphraseFocus(1)
+				return s, textinput.Blink
+			}
+
+			if s.passphraseInput.Value() == "" {
+				s.status = "Please enter a passphrase"
+				s.isError = true
+				s.setPassphraseFocus(0)
+				return s, textinput.Blink
+			}
+			if s.passphraseInput.Value() != s.confirmInput.Value() {
+				s.status = "The passphrases do not match"
+				s.isError = true
+				s.confirmInput.SetValue("")
+				return s, nil
+			}
+
+			s.state = Exporting
+			s.status = "Exporting encrypted JSON..."
+			s.isError = false
+			s.lastExportedFile = filepath.Join(s.exportPath, "books.json"+services.EncryptedExt)
+			cmd = s.performExport("encrypted")
+			s.clearPassphrase()
+			return s, cmd
+		case "esc":
+			// Go back to format selection
+			s.state = FormatSelection
+			s.status = ""
+			s.isError = false
+			s.clearPassphrase()
+			return s, nil
+		case "ctrl+c":
+			return s, tea.Quit
+		}
+	}
+
+	// Update whichever passphrase input is focused
+	if s.passphraseFocus == 0 {
+		s.passphraseInput, cmd = s.passphraseInput.Update(msg)
+	} else {
+		s.confirmInput, cmd = s.confirmInput.Update(msg)
+	}
+	return s, cmd
+}
+
+// setPassphraseFocus focuses the passphrase (0) or confirmation (1) input and blurs the other
+func (s *ExportScreen) setPassphraseFocus(index int) {
+	s.passphraseFocus = index
+	if index == 0 {
+		s.passphraseInput.Focus()
+		s.confirmInput.Blur()
+	} else {
+		s.passphraseInput.Blur()
+		s.confirmInput.Focus()
+	}
+}
+
+// clearPassphrase empties and blurs both passphrase inputs, so the passphrase
+// is not kept once it is no longer needed
+func (s *ExportScreen) clearPassphrase() {
+	s.passphraseInput.Reset()
+	s.confirmInput.Reset()
+	s.passphraseInput.Blur()
+	s.confirmInput.Blur()
+	s.passphraseFocus = 0
+}
+
 func (s *ExportScreen) updateExporting(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
@@ -225,6 +607,15 @@ func (s *ExportScreen) updateExporting(msg tea.Msg) (tea.Model, tea.Cmd) {
 			s.isError = true
 		} else {
 			s.status = "Export completed successfully!\n\nFile saved to: " + s.lastExportedFile
+			if msg.Files > 0 {
+				s.status = fmt.Sprintf("Export completed successfully!\n\nWrote %d file(s) to: %s", msg.Files, s.lastExportedFile)
+			}
+			if msg.Checksum != "" {
+				s.status += "\nChecksum saved to: " + msg.Checksum
+			}
+			if msg.Warning != "" {
+				s.status += "\n\n" + msg.Warning
+			}
 			s.isError = false
 		}
 		s.state = ShowResult
@@ -241,14 +632,36 @@ func (s *ExportScreen) updateShowResult(msg tea.Msg) (tea.Model, tea.Cmd) {
 			s.state = FormatSelection
 			s.status = ""
 			s.isError = false
+			s.folderNote = ""
 			return s, nil
+		case "o":
+			if !s.isError {
+				return s, openFolderCmd(s.exportPath)
+			}
 		case "q", "ctrl+c":
 			return s, tea.Quit
 		}
+	case messages.OpenFolderMsg:
+		switch {
+		case msg.Err == nil:
+			s.folderNote = "Opened " + msg.Path
+		case errors.Is(msg.Err, browser.ErrUnsupportedPlatform), errors.Is(msg.Err, browser.ErrNoDisplay):
+			s.folderNote = "No file manager available. The export folder is: " + msg.Path
+		default:
+			s.folderNote = fmt.Sprintf("Could not open the folder (%v). The export folder is: %s", msg.Err, msg.Path)
+		}
 	}
 	return s, nil
 }
 
+// openFolderCmd opens the export folder in the system file manager,
+// reporting the result as an OpenFolderMsg
+func openFolderCmd(path string) tea.Cmd {
+	return func() tea.Msg {
+		return messages.OpenFolderMsg{Path: path, Err: browser.New().Open(path)}
+	}
+}
+
 func (s *ExportScreen) validatePath(path string) error {
 	// Check if directory exists
 	if _, err := os.Stat(path); os.IsNotExist(err) {
@@ -272,7 +685,7 @@ func (s *ExportScreen) View() string {
 
 	// Display title
 	b.WriteString("\n")
-	b.WriteString(styles.TitleStyle().Render("Ｅｘｐｏｒｔ　Ｂｏｏｋ　Ｃｏｌｌｅｃｔｉｏｎ"))
+	b.WriteString(styles.TitleStyle().Render(styles.Label("Ｅｘｐｏｒｔ　Ｂｏｏｋ　Ｃｏｌｌｅｃｔｉｏｎ")))
 	b.WriteString("\n\n")
 
 	switch s.state {
@@ -294,17 +707,72 @@ func (s *ExportScreen) View() string {
 			b.WriteString("\n")
 		}
 		
-		b.WriteString("\n" + styles.HelpTextStyle.Render(styles.AddLetterSpacing("Enter to continue, Esc to go back")))
+		b.WriteString("\n" + renderHelp(help.ExportPath))
+
+	case DateRangeInput:
+		b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing("Export to: " + s.exportPath)))
+		b.WriteString("\n\n")
+		b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing("Only export books added between these dates (leave blank for all time):")))
+		b.WriteString("\n\n")
+		b.WriteString(styles.FocusedStyle().Render(styles.AddLetterSpacing("Start date:")))
+		b.WriteString("\n")
+		b.WriteString(s.startInput.View())
+		b.WriteString("\n\n")
+		b.WriteString(styles.FocusedStyle().Render(styles.AddLetterSpacing("End date:")))
+		b.WriteString("\n")
+		b.WriteString(s.endInput.View())
+		b.WriteString("\n\n")
+
+		if s.status != "" && s.isError {
+			b.WriteString("\n" + styles.ErrorStyle.Render(styles.AddLetterSpacing(s.status)))
+			b.WriteString("\n")
+		}
+
+		b.WriteString("\n" + renderHelp(help.ExportDates))
 
 	case FormatSelection:
 		b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing("Export to: " + s.exportPath)))
 		b.WriteString("\n\n")
+		b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing("Date range: " + s.describeDateRange())))
+		b.WriteString("\n\n")
+		checksum := "Off"
+		if s.checksum {
+			checksum = "On"
+		}
+		b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing("Checksum manifest (.sha256): " + checksum)))
+		b.WriteString("\n\n")
 		b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing("Select export format:")))
 		b.WriteString("\n\n")
 
 		// Render format options
 		for i, item := range s.formatItems {
 			if i == s.formatIndex {
+				b.WriteString(styles.SelectedStyle().Render(styles.Label(item)))
+			} else {
+				b.WriteString(styles.BlurredStyle.Render(styles.Label(item)))
+			}
+			b.WriteString("\n\n")
+		}
+
+		b.WriteString("\n" + renderHelp(help.ExportFormat))
+
+	case FieldSelection:
+		b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing("Export to: " + s.exportPath)))
+		b.WriteString("\n\n")
+		b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing("Select the fields to include (the ID and title are always included):")))
+		b.WriteString("\n")
+		// The Calibre and Goodreads importers expect their own columns, so the CSV exports skip this step
+		b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing("Calibre and Goodreads CSV exports always write their fixed column set.")))
+		b.WriteString("\n\n")
+
+		// Render the field checklist
+		for i, field := range services.ExportFields {
+			check := "[ ] "
+			if s.fieldIncluded[field] {
+				check = "[x] "
+			}
+			item := styles.AddLetterSpacing(check + exportFieldLabels[field])
+			if i == s.fieldIndex {
 				b.WriteString(styles.SelectedStyle().Render(item))
 			} else {
 				b.WriteString(styles.BlurredStyle.Render(item))
@@ -312,7 +780,28 @@ func (s *ExportScreen) View() string {
 			b.WriteString("\n\n")
 		}
 
-		b.WriteString("\n" + styles.HelpTextStyle.Render(styles.AddLetterSpacing("Use ↑/↓ or j/k to navigate, Enter to select, Esc to go back")))
+		b.WriteString("\n" + renderHelp(help.ExportFields))
+
+	case PassphraseInput:
+		b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing("Export to: " + s.exportPath)))
+		b.WriteString("\n\n")
+		b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing("Choose a passphrase. The export cannot be read without it, so keep it somewhere safe:")))
+		b.WriteString("\n\n")
+		b.WriteString(styles.FocusedStyle().Render(styles.AddLetterSpacing("Passphrase:")))
+		b.WriteString("\n")
+		b.WriteString(s.passphraseInput.View())
+		b.WriteString("\n\n")
+		b.WriteString(styles.FocusedStyle().Render(styles.AddLetterSpacing("Confirm passphrase:")))
+		b.WriteString("\n")
+		b.WriteString(s.confirmInput.View())
+		b.WriteString("\n\n")
+
+		if s.status != "" && s.isError {
+			b.WriteString("\n" + styles.ErrorStyle.Render(styles.AddLetterSpacing(s.status)))
+			b.WriteString("\n")
+		}
+
+		b.WriteString("\n" + renderHelp(help.ExportPassphrase))
 
 	case Exporting:
 		b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing("Export to: " + s.exportPath)))
@@ -332,7 +821,7 @@ func (s *ExportScreen) View() string {
 			b.WriteString("\n" + statusStyle.Render(styles.AddLetterSpacing(s.status)))
 		}
 		b.WriteString("\n\n")
-		b.WriteString("\n" + styles.HelpTextStyle.Render(styles.AddLetterSpacing("Esc to go back")))
+		b.WriteString("\n" + renderHelp(help.ExportRunning))
 
 	case ShowResult:
 		b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing("Export to: " + s.exportPath)))
@@ -363,35 +852,103 @@ func (s *ExportScreen) View() string {
 				}
 			}
 		}
+		if s.folderNote != "" {
+			b.WriteString("\n\n" + styles.BlurredStyle.Render(styles.AddLetterSpacing(s.folderNote)))
+		}
 		b.WriteString("\n\n")
-		b.WriteString("\n" + styles.HelpTextStyle.Render(styles.AddLetterSpacing("Press Enter or Esc to continue")))
+		if s.isError {
+			b.WriteString("\n" + renderHelp(help.ExportResultError))
+		} else {
+			b.WriteString("\n" + renderHelp(help.ExportResult))
+		}
 	}
 
 	return b.String()
 }
 
-func (s *ExportScreen) performExport(format string) tea.Cmd {
+// performExport writes the export in the given format; fields lists the optional
+// fields of a JSON or JSON Lines export and is ignored by the other formats.
+// An encrypted export uses the passphrase typed when the export is started
+func (s *ExportScreen) performExport(format string, fields ...string) tea.Cmd {
+	exportedFile, checksum := s.lastExportedFile, s.checksum
+	passphrase := s.passphraseInput.Value()
+	manifestPath := filepath.Join(s.defaultExportsDir, services.ManifestName)
 	return func() tea.Msg {
 		// Ensure export directory exists
 		if err := os.MkdirAll(s.exportPath, constants.DirPermissions); err != nil {
 			return messages.BackupMsg{Err: err}
 		}
 
-		// Load books from database
-		books, err := s.db.LoadBooks()
+		// Load books from database, limited to the selected date range
+		books, err := s.loadBooks()
 		if err != nil {
 			return messages.BackupMsg{Err: fmt.Errorf("failed to load books: %v", err)}
 		}
+		if len(books) == 0 {
+			return messages.BackupMsg{Err: fmt.Errorf("no books to export")}
+		}
 
 		// Create backup service and export
 		backupService := services.NewBackupService()
+		files := 0
 		switch format {
 		case "json":
-			err = backupService.ExportToJSON(books, filepath.Join(s.exportPath, "books.json"))
+			err = backupService.ExportToJSONFields(books, fields, filepath.Join(s.exportPath, "books.json"))
+		case "jsonl":
+			err = backupService.ExportToJSONLFields(books, fields, filepath.Join(s.exportPath, "books.jsonl"))
+		case "encrypted":
+			err = backupService.ExportEncryptedJSON(books, exportedFile, passphrase)
 		case "markdown":
 			err = backupService.ExportToMarkdown(books, filepath.Join(s.exportPath, "books.md"))
+		case "markdown-toc":
+			err = backupService.ExportToMarkdownWithTOC(books, filepath.Join(s.exportPath, "books-contents.md"))
+		case "per-book":
+			// A folder of files has no single checksum, so none is written
+			err = backupService.ExportPerBook(books, exportedFile)
+			files = len(books)
+		case "text":
+			err = backupService.ExportToText(books, filepath.Join(s.exportPath, "books.txt"))
+		case "timeline":
+			err = backupService.ExportTimeline(books, filepath.Join(s.exportPath, "books-timeline.md"))
+		case "journal":
+			err = backupService.ExportNotes(books, filepath.Join(s.exportPath, "books-journal.md"))
+		case "calibre":
+			err = backupService.ExportToCalibreCSV(books, filepath.Join(s.exportPath, "books-calibre.csv"))
+		case "goodreads":
+			err = backupService.ExportToGoodreadsCSV(books, filepath.Join(s.exportPath, "books-goodreads.csv"))
+		case "stats":
+			err = backupService.ExportStats(models.ComputeStats(books), filepath.Join(s.exportPath, "stats.json"))
+		}
+		if err != nil {
+			return messages.BackupMsg{Err: err}
+		}
+
+		// The export itself succeeded, so checksum and manifest problems are only reported
+		result := messages.BackupMsg{Files: files}
+		var warnings []string
+		if checksum && files == 0 {
+			sumPath, err := backupService.WriteChecksum(exportedFile)
+			if err != nil {
+				warnings = append(warnings, "Checksum not written: "+err.Error())
+			}
+			result.Checksum = sumPath
+		}
+		entry := models.ManifestEntry{Time: time.Now(), Format: format, Path: exportedFile, Books: len(books)}
+		if err := backupService.AppendManifest(manifestPath, entry); err != nil {
+			warnings = append(warnings, "Export not recorded in "+manifestPath+": "+err.Error())
 		}
+		result.Warning = strings.Join(warnings, "\n")
+
+		return result
+	}
+}
 
-		return messages.BackupMsg{Err: err}
+// loadBooks returns the books to export, honoring the selected date range
+// The end date is inclusive, so it is extended to the last second of that day
+func (s *ExportScreen) loadBooks() ([]models.Book, error) {
+	end := s.rangeEnd
+	if !end.IsZero() {
+		end = end.AddDate(0, 0, 1).Add(-time.Second)
 	}
-}
\ No newline at end of file
+	return s.db.LoadBooksBetween(s.rangeStart, end)
+}
@@ -0,0 +1,11 @@
+package screens
+
+import (
+	"github.com/papadavis47/libros/internal/help"
+	"github.com/papadavis47/libros/internal/styles"
+)
+
+// renderHelp renders the help line id in the footer style shared by every screen
+func renderHelp(id help.ID) string {
+	return styles.HelpTextStyle.Render(styles.AddLetterSpacing(help.Text(id)))
+}
@@ -0,0 +1,207 @@
+package screens
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/papadavis47/libros/internal/constants"
+	"github.com/papadavis47/libros/internal/database"
+	"github.com/papadavis47/libros/internal/help"
+	"github.com/papadavis47/libros/internal/messages"
+	"github.com/papadavis47/libros/internal/models"
+	"github.com/papadavis47/libros/internal/styles"
+	"github.com/papadavis47/libros/internal/utils"
+)
+
+// HistoryScreen lists the earlier versions of a book saved by its edits and
+// restores one after confirmation. Restoring saves the current values to the
+// history first, so a restore can itself be undone.
+type HistoryScreen struct {
+	db         *database.DB
+	book       *models.Book // Book shown on the detail screen; updated in place on restore
+	loading    bool
+	confirming bool
+	restoring  bool
+	versions   []models.BookVersion
+	index      int
+	status     string
+	err        error
+}
+
+func NewHistoryScreen(db *database.DB) *HistoryScreen {
+	return &HistoryScreen{db: db}
+}
+
+// Start clears the previous book's history and loads the history of book.
+// It returns the command that loads it.
+func (s *HistoryScreen) Start(book *models.Book) tea.Cmd {
+	s.book = book
+	s.loading = true
+	s.confirming = false
+	s.restoring = false
+	s.versions = nil
+	s.index = 0
+	s.status = ""
+	s.err = nil
+	return s.loadHistoryCmd()
+}
+
+func (s *HistoryScreen) Init() tea.Cmd {
+	return nil
+}
+
+func (s *HistoryScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		// Ignore input while the history is loaded or a version is restored
+		if s.loading || s.restoring {
+			return s, nil
+		}
+		if s.confirming {
+			switch msg.String() {
+			case "y":
+				s.restoring = true
+				return s, s.restoreVersionCmd(s.versions[s.index].ID)
+			case "n", "esc":
+				s.confirming = false
+			}
+			return s, nil
+		}
+		switch msg.String() {
+		case "up", "k":
+			if s.index > 0 {
+				s.index--
+			}
+		case "down", "j":
+			if s.index < len(s.versions)-1 {
+				s.index++
+			}
+		case "enter":
+			if len(s.versions) > 0 {
+				s.confirming = true
+				s.status = ""
+			}
+		case "esc":
+			// Return to the book's details
+			return s, SwitchScreenCmd(models.BookDetailScreen)
+		}
+
+	case messages.HistoryMsg:
+		s.loading = false
+		s.versions = msg.Versions
+		s.err = msg.Err
+
+	case messages.RestoreMsg:
+		s.restoring = false
+		s.confirming = false
+		if msg.Err != nil {
+			s.status = "Could not restore: " + msg.Err.Error()
+			return s, nil
+		}
+		// The book is shared with the detail screen and the list
+		s.book.Title = msg.Version.Title
+		s.book.Author = msg.Version.Author
+		s.book.Type = msg.Version.Type
+		s.book.Notes = msg.Version.Notes
+		s.book.Location = msg.Version.Location
+		return s, SwitchScreenCmd(models.BookDetailScreen)
+	}
+
+	return s, nil
+}
+
+func (s *HistoryScreen) View() string {
+	var b strings.Builder
+
+	b.WriteString("\n")
+	b.WriteString(styles.TitleStyle().Render(styles.Label("Ｅｄｉｔ　Ｈｉｓｔｏｒｙ")))
+	b.WriteString("\n\n")
+
+	if s.book != nil {
+		b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing("Book: " + s.book.Title)))
+		b.WriteString("\n\n")
+	}
+
+	switch {
+	case s.loading:
+		b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing("Loading history...")))
+		b.WriteString("\n")
+		return b.String()
+	case s.err != nil:
+		b.WriteString(styles.ErrorStyle.Render(styles.AddLetterSpacing("Error: " + s.err.Error())))
+		b.WriteString("\n")
+		b.WriteString("\n" + renderHelp(help.HistoryEmpty))
+		return b.String()
+	case len(s.versions) == 0:
+		b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing("No earlier versions yet. A version is saved each time the book is edited.")))
+		b.WriteString("\n")
+		b.WriteString("\n" + renderHelp(help.HistoryEmpty))
+		return b.String()
+	}
+
+	// List each saved version by when it was replaced
+	for i, version := range s.versions {
+		item := fmt.Sprintf("%s, %s — %s by %s", utils.FormatDate(version.ChangedAt), version.ChangedAt.Local().Format("3:04 PM"), version.Title, version.Author)
+		if i == s.index {
+			b.WriteString(styles.SelectedStyle().Render(styles.AddLetterSpacing(item)))
+		} else {
+			b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing(item)))
+		}
+		b.WriteString("\n\n")
+	}
+
+	// Show the rest of the highlighted version
+	version := s.versions[s.index]
+	b.WriteString(styles.FocusedStyle().Render(styles.AddLetterSpacing("Type: ")) + styles.TypeLabel(version.Type) + "\n")
+	if version.Location != "" {
+		b.WriteString(styles.FocusedStyle().Render(styles.AddLetterSpacing("Location: ")) + styles.AddLetterSpacing(version.Location) + "\n")
+	}
+	if version.Notes != "" {
+		b.WriteString(styles.FocusedStyle().Render(styles.AddLetterSpacing("Notes: ")) + "\n\n")
+		b.WriteString(styles.SpacedNotesStyle.Render("\"" + styles.AddLetterSpacing(utils.WrapText(truncateNotes(version.Notes, constants.NoteTruncateLength), constants.TextWrapWidth)) + "\""))
+		b.WriteString("\n")
+	}
+
+	if s.status != "" {
+		statusStyle := lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#FF0000")).
+			Bold(true).
+			Padding(1, 0).
+			PaddingLeft(3)
+		b.WriteString(statusStyle.Render(styles.AddLetterSpacing(s.status)))
+		b.WriteString("\n")
+	}
+
+	switch {
+	case s.restoring:
+		b.WriteString("\n" + styles.BlurredStyle.Render(styles.AddLetterSpacing("Restoring...")))
+	case s.confirming:
+		b.WriteString("\n" + styles.ErrorStyle.Render(styles.AddLetterSpacing("Restore this version? The current values are saved to the history first.")))
+		b.WriteString("\n" + renderHelp(help.HistoryConfirm))
+	default:
+		b.WriteString("\n" + renderHelp(help.History))
+	}
+
+	return b.String()
+}
+
+// loadHistoryCmd loads the book's saved versions asynchronously,
+// reporting them as a HistoryMsg.
+func (s *HistoryScreen) loadHistoryCmd() tea.Cmd {
+	bookID := s.book.ID
+	return func() tea.Msg {
+		versions, err := s.db.LoadHistory(bookID)
+		return messages.HistoryMsg{Versions: versions, Err: err}
+	}
+}
+
+// restoreVersionCmd restores the version asynchronously,
+// reporting the result as a RestoreMsg.
+func (s *HistoryScreen) restoreVersionCmd(versionID int) tea.Cmd {
+	return func() tea.Msg {
+		version, err := s.db.RestoreVersion(versionID)
+		return messages.RestoreMsg{Version: version, Err: err}
+	}
+}
@@ -0,0 +1,328 @@
+package screens
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/papadavis47/libros/internal/database"
+	"github.com/papadavis47/libros/internal/factory"
+	"github.com/papadavis47/libros/internal/help"
+	"github.com/papadavis47/libros/internal/messages"
+	"github.com/papadavis47/libros/internal/models"
+	"github.com/papadavis47/libros/internal/services"
+	"github.com/papadavis47/libros/internal/styles"
+)
+
+// ImportState represents the current step of the import flow
+type ImportState int
+
+const (
+	ImportPathInput  ImportState = iota // Getting the title list file path from the user
+	ImportPassphrase                    // Getting the passphrase of an encrypted JSON export
+	ImportReading                       // Reading and parsing the file
+	ImportPreview                       // Showing how many lines will import before confirming
+	Importing                           // Currently adding the books
+	ImportResult                        // Showing the import result (success/error)
+)
+
+// ImportScreen adds books from a plain-text file with one "Title - Author" per line,
+// or from an encrypted JSON export after asking for its passphrase.
+// The file is parsed first so the user can see how many lines will import, and
+// which will be skipped, before anything is written.
+type ImportScreen struct {
+	db              *database.DB
+	state           ImportState
+	pathInput       textinput.Model
+	passphraseInput textinput.Model
+	filePath        string
+	encrypted       bool          // Whether the file is an encrypted JSON export rather than a title list
+	books           []models.Book // Books parsed from the file, waiting for confirmation
+	skipped         []int         // Line numbers that could not be parsed
+	status          string
+	isError         bool
+}
+
+func NewImportScreen(db *database.DB) *ImportScreen {
+	return &ImportScreen{
+		db:              db,
+		pathInput:       factory.CreatePathInput("~/recommendations.txt"),
+		passphraseInput: factory.CreatePassphraseInput("Passphrase"),
+	}
+}
+
+// Reset clears the previous file and result so the screen starts fresh
+// each time it is opened. It returns the command that focuses the path input.
+func (s *ImportScreen) Reset() tea.Cmd {
+	s.state = ImportPathInput
+	s.pathInput.Reset()
+	s.passphraseInput.Reset()
+	s.passphraseInput.Blur()
+	s.filePath = ""
+	s.encrypted = false
+	s.books = nil
+	s.skipped = nil
+	s.status = ""
+	s.isError = false
+	return s.pathInput.Focus()
+}
+
+func (s *ImportScreen) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+func (s *ImportScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch s.state {
+		case ImportPathInput:
+			switch msg.String() {
+			case "enter":
+				path, err := expandHome(strings.TrimSpace(s.pathInput.Value()))
+				if err != nil {
+					s.status = err.Error()
+					s.isError = true
+					return s, nil
+				}
+				s.filePath = path
+				s.status = ""
+				s.isError = false
+				s.pathInput.Blur()
+				// An encrypted export needs its passphrase before it can be read
+				s.encrypted = strings.HasSuffix(path, services.EncryptedExt)
+				if s.encrypted {
+					s.state = ImportPassphrase
+					return s, s.passphraseInput.Focus()
+				}
+				s.state = ImportReading
+				return s, s.readTitleListCmd(path)
+			case "esc":
+				return s, SwitchScreenCmd(models.UtilitiesScreen)
+			}
+		case ImportPassphrase:
+			switch msg.String() {
+			case "enter":
+				passphrase := s.passphraseInput.Value()
+				if passphrase == "" {
+					s.status = "Please enter the passphrase"
+					s.isError = true
+					return s, nil
+				}
+				s.state = ImportReading
+				s.status = ""
+				s.isError = false
+				s.passphraseInput.Reset()
+				s.passphraseInput.Blur()
+				return s, s.readEncryptedCmd(s.filePath, passphrase)
+			case "esc":
+				// Go back and choose another file
+				s.state = ImportPathInput
+				s.status = ""
+				s.isError = false
+				s.passphraseInput.Reset()
+				s.passphraseInput.Blur()
+				return s, s.pathInput.Focus()
+			}
+		case ImportPreview:
+			switch msg.String() {
+			case "y":
+				if len(s.books) == 0 {
+					return s, nil
+				}
+				s.state = Importing
+				return s, s.importBooksCmd(s.books)
+			case "n", "esc":
+				// Go back and choose another file
+				s.state = ImportPathInput
+				return s, s.pathInput.Focus()
+			}
+			return s, nil
+		case ImportResult:
+			switch msg.String() {
+			case "enter", "esc":
+				return s, SwitchScreenCmd(models.UtilitiesScreen)
+			}
+			return s, nil
+		default:
+			// Ignore input while the file is read or the books are added
+			return s, nil
+		}
+
+	case messages.ImportPreviewMsg:
+		if msg.Err != nil {
+			s.status = "Could not read file: " + msg.Err.Error()
+			s.isError = true
+			// An encrypted file asks again, since the passphrase is the likely mistake
+			if s.encrypted {
+				s.state = ImportPassphrase
+				return s, s.passphraseInput.Focus()
+			}
+			s.state = ImportPathInput
+			return s, s.pathInput.Focus()
+		}
+		s.books = msg.Books
+		s.skipped = msg.Skipped
+		s.state = ImportPreview
+		return s, nil
+
+	case messages.ImportMsg:
+		s.state = ImportResult
+		if msg.Err != nil {
+			s.status = "Import failed: " + msg.Err.Error()
+			s.isError = true
+		} else {
+			s.status = fmt.Sprintf("Imported %d book(s)", msg.Imported)
+			s.isError = false
+		}
+		return s, nil
+	}
+
+	var cmd tea.Cmd
+	switch s.state {
+	case ImportPathInput:
+		s.pathInput, cmd = s.pathInput.Update(msg)
+	case ImportPassphrase:
+		s.passphraseInput, cmd = s.passphraseInput.Update(msg)
+	}
+	return s, cmd
+}
+
+func (s *ImportScreen) View() string {
+	var b strings.Builder
+
+	b.WriteString("\n")
+	b.WriteString(styles.TitleStyle().Render(styles.Label("Ｉｍｐｏｒｔ　Ｔｉｔｌｅｓ")))
+	b.WriteString("\n\n")
+
+	statusStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#00FF00")).
+		Bold(true).
+		Padding(1, 0).
+		PaddingLeft(3)
+	if s.isError {
+		statusStyle = statusStyle.Foreground(lipgloss.Color("#FF0000"))
+	}
+
+	switch s.state {
+	case ImportPathInput:
+		b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing("Enter the path of a text file with one \"Title - Author\" per line, or of an encrypted export (" + services.EncryptedExt + "):")))
+		b.WriteString("\n\n")
+		b.WriteString(s.pathInput.View())
+		b.WriteString("\n")
+		if s.status != "" {
+			b.WriteString(statusStyle.Render(styles.AddLetterSpacing(s.status)))
+			b.WriteString("\n")
+		}
+		b.WriteString("\n" + renderHelp(help.Import))
+
+	case ImportPassphrase:
+		b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing("File: " + s.filePath)))
+		b.WriteString("\n\n")
+		b.WriteString(styles.FocusedStyle().Render(styles.AddLetterSpacing("Passphrase:")))
+		b.WriteString("\n")
+		b.WriteString(s.passphraseInput.View())
+		b.WriteString("\n")
+		if s.status != "" {
+			b.WriteString(statusStyle.Render(styles.AddLetterSpacing(s.status)))
+			b.WriteString("\n")
+		}
+		b.WriteString("\n" + renderHelp(help.ImportPassphrase))
+
+	case ImportReading:
+		b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing("Reading " + s.filePath + "...")))
+		b.WriteString("\n")
+
+	case ImportPreview:
+		b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing("File: " + s.filePath)))
+		b.WriteString("\n\n")
+		if s.encrypted {
+			b.WriteString(styles.SuccessStyle.Render(styles.AddLetterSpacing(fmt.Sprintf("%d book(s) will import with their type, notes, location, added date, and pinned, reading and lent details", len(s.books)))))
+		} else {
+			b.WriteString(styles.SuccessStyle.Render(styles.AddLetterSpacing(fmt.Sprintf("%d line(s) will import as paperbacks", len(s.books)))))
+		}
+		b.WriteString("\n\n")
+		if len(s.skipped) > 0 {
+			b.WriteString(styles.ErrorStyle.Render(styles.AddLetterSpacing(fmt.Sprintf("%d line(s) will be skipped: %s", len(s.skipped), joinLineNumbers(s.skipped)))))
+			b.WriteString("\n\n")
+		}
+		if len(s.books) == 0 {
+			b.WriteString("\n" + renderHelp(help.ImportPreviewEmpty))
+		} else {
+			b.WriteString("\n" + renderHelp(help.ImportPreview))
+		}
+
+	case Importing:
+		b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing("Importing...")))
+		b.WriteString("\n")
+
+	case ImportResult:
+		b.WriteString(statusStyle.Render(styles.AddLetterSpacing(s.status)))
+		b.WriteString("\n")
+		if !s.isError && len(s.skipped) > 0 {
+			b.WriteString(styles.ErrorStyle.Render(styles.AddLetterSpacing("Skipped lines: " + joinLineNumbers(s.skipped))))
+			b.WriteString("\n")
+		}
+		b.WriteString("\n" + renderHelp(help.ImportResult))
+	}
+
+	return b.String()
+}
+
+// readTitleListCmd reads and parses the title list asynchronously,
+// reporting the result as an ImportPreviewMsg.
+func (s *ImportScreen) readTitleListCmd(path string) tea.Cmd {
+	return func() tea.Msg {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return messages.ImportPreviewMsg{Err: err}
+		}
+		books, skipped := services.ParseTitleList(string(content))
+		return messages.ImportPreviewMsg{Books: books, Skipped: skipped}
+	}
+}
+
+// readEncryptedCmd decrypts and reads an encrypted JSON export asynchronously,
+// reporting the result as an ImportPreviewMsg.
+func (s *ImportScreen) readEncryptedCmd(path, passphrase string) tea.Cmd {
+	return func() tea.Msg {
+		books, err := services.NewBackupService().ImportEncryptedJSON(path, passphrase)
+		return messages.ImportPreviewMsg{Books: books, Err: err}
+	}
+}
+
+// importBooksCmd adds the parsed books asynchronously,
+// reporting the result as an ImportMsg.
+func (s *ImportScreen) importBooksCmd(books []models.Book) tea.Cmd {
+	return func() tea.Msg {
+		imported, err := s.db.ImportBooks(books)
+		return messages.ImportMsg{Imported: imported, Err: err}
+	}
+}
+
+// expandHome requires an absolute path and expands a leading ~ to the home directory
+func expandHome(path string) (string, error) {
+	if !strings.HasPrefix(path, "/") && !strings.HasPrefix(path, "~") {
+		return "", fmt.Errorf("Please enter an absolute path (starting with / or ~)")
+	}
+	if strings.HasPrefix(path, "~") {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("Error getting home directory: %v", err)
+		}
+		path = strings.Replace(path, "~", homeDir, 1)
+	}
+	return path, nil
+}
+
+// joinLineNumbers lists line numbers separated by commas, e.g. "3, 7, 12"
+func joinLineNumbers(lines []int) string {
+	parts := make([]string, len(lines))
+	for i, line := range lines {
+		parts[i] = strconv.Itoa(line)
+	}
+	return strings.Join(parts, ", ")
+}
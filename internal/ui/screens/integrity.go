@@ -0,0 +1,136 @@
+package screens
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/papadavis47/libros/internal/database"
+	"github.com/papadavis47/libros/internal/help"
+	"github.com/papadavis47/libros/internal/messages"
+	"github.com/papadavis47/libros/internal/models"
+	"github.com/papadavis47/libros/internal/styles"
+)
+
+// IntegrityScreen runs SQLite's integrity check and reports the outcome.
+// A spinner is shown while the check runs, followed by either an OK
+// message or the list of problems reported by the database.
+type IntegrityScreen struct {
+	db       *database.DB
+	spinner  spinner.Model
+	running  bool
+	ok       bool
+	problems []string
+	err      error
+}
+
+func NewIntegrityScreen(db *database.DB) *IntegrityScreen {
+	return &IntegrityScreen{
+		db:      db,
+		spinner: spinner.New(spinner.WithSpinner(spinner.Dot)),
+	}
+}
+
+// Start resets any previous result and begins a new integrity check.
+// It returns the commands that drive the spinner and run the check.
+func (s *IntegrityScreen) Start() tea.Cmd {
+	s.running = true
+	s.ok = false
+	s.problems = nil
+	s.err = nil
+	s.spinner.Style = styles.FocusedStyle()
+	return tea.Batch(s.spinner.Tick, s.checkIntegrityCmd())
+}
+
+func (s *IntegrityScreen) Init() tea.Cmd {
+	return nil
+}
+
+func (s *IntegrityScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "esc", "enter":
+			// Ignore enter until the check has finished
+			if s.running && msg.String() == "enter" {
+				return s, nil
+			}
+			// Return to utilities screen
+			return s, SwitchScreenCmd(models.UtilitiesScreen)
+		case "q", "ctrl+c":
+			return s, tea.Quit
+		}
+
+	case spinner.TickMsg:
+		// Keep the spinner animating only while the check is running
+		if !s.running {
+			return s, nil
+		}
+		var cmd tea.Cmd
+		s.spinner, cmd = s.spinner.Update(msg)
+		return s, cmd
+
+	case messages.IntegrityMsg:
+		s.running = false
+		s.ok = msg.OK
+		s.problems = msg.Problems
+		s.err = msg.Err
+	}
+
+	return s, nil
+}
+
+func (s *IntegrityScreen) View() string {
+	var b strings.Builder
+
+	b.WriteString("\n")
+	b.WriteString(styles.TitleStyle().Render(styles.Label("Ｄａｔａｂａｓｅ　Ｉｎｔｅｇｒｉｔｙ")))
+	b.WriteString("\n\n")
+
+	if s.running {
+		b.WriteString("   " + s.spinner.View() + " " + styles.BlurredNoPaddingStyle.Render(styles.AddLetterSpacing("Checking database integrity...")))
+		b.WriteString("\n\n")
+		b.WriteString("\n" + renderHelp(help.IntegrityRunning))
+		return b.String()
+	}
+
+	statusStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#00FF00")).
+		Bold(true).
+		Padding(1, 0).
+		PaddingLeft(3)
+
+	switch {
+	case s.err != nil:
+		statusStyle = statusStyle.Foreground(lipgloss.Color("#FF0000"))
+		b.WriteString(statusStyle.Render(styles.AddLetterSpacing("Integrity check failed: " + s.err.Error())))
+		b.WriteString("\n")
+	case s.ok:
+		b.WriteString(statusStyle.Render(styles.AddLetterSpacing("Database OK - no problems found")))
+		b.WriteString("\n")
+	default:
+		statusStyle = statusStyle.Foreground(lipgloss.Color("#FF0000"))
+		b.WriteString(statusStyle.Render(styles.AddLetterSpacing(fmt.Sprintf("Found %d problem(s):", len(s.problems)))))
+		b.WriteString("\n")
+		// List every problem row reported by SQLite
+		for _, problem := range s.problems {
+			b.WriteString(styles.ErrorStyle.Render("• " + problem))
+			b.WriteString("\n")
+		}
+	}
+
+	b.WriteString("\n" + renderHelp(help.Integrity))
+
+	return b.String()
+}
+
+// checkIntegrityCmd runs the integrity check asynchronously and reports
+// the result as an IntegrityMsg.
+func (s *IntegrityScreen) checkIntegrityCmd() tea.Cmd {
+	return func() tea.Msg {
+		ok, problems, err := s.db.CheckIntegrity()
+		return messages.IntegrityMsg{OK: ok, Problems: problems, Err: err}
+	}
+}
@@ -0,0 +1,184 @@
+package screens
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/papadavis47/libros/internal/database"
+	"github.com/papadavis47/libros/internal/help"
+	"github.com/papadavis47/libros/internal/messages"
+	"github.com/papadavis47/libros/internal/models"
+	"github.com/papadavis47/libros/internal/styles"
+)
+
+// LibraryScreen lists the library databases (.db files) kept beside the current one
+// and opens the chosen library. The root model swaps in the new database once it
+// has opened; if it fails to open, the current library stays in use.
+type LibraryScreen struct {
+	db      *database.DB
+	loading bool
+	opening bool
+	paths   []string
+	index   int
+	status  string
+	err     error
+}
+
+func NewLibraryScreen(db *database.DB) *LibraryScreen {
+	return &LibraryScreen{db: db}
+}
+
+// Start clears any previous error and looks for library files.
+// It returns the command that lists them.
+func (s *LibraryScreen) Start() tea.Cmd {
+	s.loading = true
+	s.opening = false
+	s.paths = nil
+	s.index = 0
+	s.status = ""
+	s.err = nil
+	return s.listLibrariesCmd()
+}
+
+func (s *LibraryScreen) Init() tea.Cmd {
+	return nil
+}
+
+func (s *LibraryScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		// Ignore input while the list is read or a library is opened
+		if s.loading || s.opening {
+			return s, nil
+		}
+		switch msg.String() {
+		case "up", "k":
+			if s.index > 0 {
+				s.index--
+			}
+		case "down", "j":
+			if s.index < len(s.paths)-1 {
+				s.index++
+			}
+		case "enter":
+			if len(s.paths) == 0 {
+				return s, nil
+			}
+			path := s.paths[s.index]
+			if path == s.db.Path() {
+				s.status = "Already using " + filepath.Base(path)
+				return s, nil
+			}
+			s.opening = true
+			s.status = ""
+			return s, s.openLibraryCmd(path)
+		case "esc":
+			// Return to utilities screen
+			return s, SwitchScreenCmd(models.UtilitiesScreen)
+		case "ctrl+c":
+			return s, tea.Quit
+		}
+
+	case messages.LibrariesMsg:
+		s.loading = false
+		s.paths = msg.Paths
+		s.err = msg.Err
+		// Start with the library in use selected
+		for i, path := range s.paths {
+			if path == s.db.Path() {
+				s.index = i
+			}
+		}
+
+	case messages.LibraryOpenedMsg:
+		// Only failures reach the screen; the root model handles a successful switch
+		s.opening = false
+		if msg.Err != nil {
+			s.status = "Could not open " + filepath.Base(msg.Path) + ", still using " + filepath.Base(s.db.Path()) + ": " + msg.Err.Error()
+		}
+	}
+
+	return s, nil
+}
+
+func (s *LibraryScreen) View() string {
+	var b strings.Builder
+
+	b.WriteString("\n")
+	b.WriteString(styles.TitleStyle().Render(styles.Label("Ｓｗｉｔｃｈ　Ｌｉｂｒａｒｙ")))
+	b.WriteString("\n\n")
+
+	switch {
+	case s.loading:
+		b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing("Looking for libraries...")))
+		b.WriteString("\n")
+	case s.err != nil:
+		b.WriteString(styles.ErrorStyle.Render(styles.AddLetterSpacing("Error: " + s.err.Error())))
+		b.WriteString("\n")
+	default:
+		b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing("Libraries in " + filepath.Dir(s.db.Path()) + ":")))
+		b.WriteString("\n\n")
+		for i, path := range s.paths {
+			name := filepath.Base(path)
+			if path == s.db.Path() {
+				name += " (current)"
+			}
+			if i == s.index {
+				b.WriteString(styles.SelectedStyle().Render(styles.AddLetterSpacing(name)))
+			} else {
+				b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing(name)))
+			}
+			b.WriteString("\n\n")
+		}
+	}
+
+	if s.opening {
+		b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing("Opening library...")))
+		b.WriteString("\n")
+	} else if s.status != "" {
+		statusStyle := lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#FF0000")).
+			Bold(true).
+			Padding(1, 0).
+			PaddingLeft(3)
+		b.WriteString(statusStyle.Render(styles.AddLetterSpacing(s.status)))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n" + renderHelp(help.Library))
+
+	return b.String()
+}
+
+// listLibrariesCmd lists the .db files in the current database's directory asynchronously,
+// reporting them as a LibrariesMsg.
+func (s *LibraryScreen) listLibrariesCmd() tea.Cmd {
+	return func() tea.Msg {
+		dir := filepath.Dir(s.db.Path())
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			return messages.LibrariesMsg{Err: err}
+		}
+		var paths []string
+		for _, entry := range entries {
+			if !entry.IsDir() && strings.HasSuffix(entry.Name(), ".db") {
+				paths = append(paths, filepath.Join(dir, entry.Name()))
+			}
+		}
+		sort.Strings(paths)
+		return messages.LibrariesMsg{Paths: paths}
+	}
+}
+
+// openLibraryCmd opens the chosen library's database asynchronously,
+// reporting the result as a LibraryOpenedMsg.
+func (s *LibraryScreen) openLibraryCmd(path string) tea.Cmd {
+	return func() tea.Msg {
+		db, err := database.New(path)
+		return messages.LibraryOpenedMsg{DB: db, Path: path, Err: err}
+	}
+}
@@ -6,41 +6,274 @@ package screens
 
 import (
 	"fmt"
+	"io"
+	"sort"
 	"strings"
+	"time"
 
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/papadavis47/libros/internal/config"
 	"github.com/papadavis47/libros/internal/constants"
+	"github.com/papadavis47/libros/internal/database"
+	"github.com/papadavis47/libros/internal/factory"
+	"github.com/papadavis47/libros/internal/help"
+	"github.com/papadavis47/libros/internal/i18n"
+	"github.com/papadavis47/libros/internal/keymap"
 	"github.com/papadavis47/libros/internal/messages"
 	"github.com/papadavis47/libros/internal/models"
 	"github.com/papadavis47/libros/internal/styles"
 	"github.com/papadavis47/libros/internal/utils"
+	"github.com/papadavis47/libros/internal/validation"
 )
 
 // ListBooksModel represents the book list screen that displays all books in the collection.
-// It manages the list of books, user navigation, error states, and deletion confirmations.
+// It wraps a bubbles list, which provides scrolling, pagination and filtering,
+// and tracks error states and deletion confirmations alongside it.
+// Books can be marked with x so their type can be changed together, and the
+// list can be narrowed to books added before or after a date with d.
 type ListBooksModel struct {
-	books    []models.Book // Complete list of books loaded from the database
-	index    int           // Currently selected book index (0-based)
-	offset   int           // Current scroll offset for viewport
-	pageSize int           // Number of books to display at once
-	err      error         // Any error that occurred during book operations
-	deleted  bool          // Flag indicating if a book was recently deleted (for showing success message)
+	db          *database.DB       // Database connection for changing the type of marked books
+	keys        keymap.KeyMap      // Key bindings for navigation, selection and going back
+	books       []models.Book      // Complete list of books loaded from the database
+	list        list.Model         // Scrollable, filterable list of the books
+	heading     string             // Subtitle for a subset of the collection, empty for all books
+	emptyText   string             // Message shown when the subset is empty, empty for the default
+	load        messages.BookQuery // Query that filled the list, nil for the whole collection
+	err         error              // Any error that occurred during book operations
+	deleted     bool               // Flag indicating if a book was recently deleted (for showing success message)
+	grouped     bool               // Whether books are grouped by shelf location instead of newest first
+	sortField   string             // Field books are sorted by (config.SortAdded, SortTitle or SortAuthor)
+	sortDir     string             // Sort direction (config.SortAsc or SortDesc)
+	expanded    int                // Index of the book whose full notes are shown below the list, -1 when none
+	width       int                // Terminal width for wrapping expanded notes, zero until the first window size message
+	height      int                // Terminal height the list is sized to, zero until the first window size message
+	marked      map[int]bool       // IDs of the books marked for a bulk type change
+	retyping    bool               // Whether the type selector for the marked books is shown
+	newType     int                // Index into models.BookTypes chosen for the marked books
+	retyped     string             // Confirmation of the last bulk type change, empty when none
+	newTitle    string             // Search that found no books, chosen to be added as a new book
+	notesLength int                // Characters of notes shown on each card, from the config
+	dismissSeq  int                // Counts success messages, so only the latest one's timer hides them
+	dating      bool               // Whether the date added filter input is shown
+	dateInput   textinput.Model    // Date typed for the date added filter
+	dateAfter   bool               // Whether the typed date keeps books added after it rather than before
+	dateErr     string             // Why the typed date was not applied, empty when none
+	cutoff      time.Time          // Date the list is narrowed around, zero when the date added filter is off
+	cutoffAfter bool               // Whether the applied cutoff keeps books added after it rather than before
 }
 
 // NewListBooksModel creates and initializes a new ListBooksModel instance.
-// The model starts with an empty book list and the selection index at the first position.
+// The model starts with an empty book list sized to show BooksPerPage books
+// until the terminal size is known, sorted as saved in the config.
 // Books will be loaded asynchronously via LoadBooksMsg messages.
 //
+// Parameters:
+//   - db: Database connection used to change the type of marked books
+//
 // Returns:
 //   - ListBooksModel: Initialized list model ready to receive book data
-func NewListBooksModel() ListBooksModel {
+func NewListBooksModel(db *database.DB) ListBooksModel {
+	keys := keymap.Load()
+	marked := make(map[int]bool)
+	notesLength := config.GetListNotesLength()
+	delegate := newBookDelegate(false, marked, notesLength)
+
+	l := list.New(nil, delegate, constants.TextAreaWidth, constants.BooksPerPage*delegate.Height()+1)
+	// The screen draws its own title, counts and help text
+	l.SetShowTitle(false)
+	l.SetShowStatusBar(false)
+	l.SetShowPagination(false)
+	l.SetShowHelp(false)
+	l.DisableQuitKeybindings()
+	l.SetStatusBarItemName("book", "books")
+	l.FilterInput.Prompt = "   Filter: "
+	l.Filter = filterBooks
+	// Navigate with the configured keys, and free up g for grouping
+	l.KeyMap.CursorUp = key.NewBinding(key.WithKeys(keys[keymap.Up]...))
+	l.KeyMap.CursorDown = key.NewBinding(key.WithKeys(keys[keymap.Down]...))
+	l.KeyMap.GoToStart = key.NewBinding(key.WithKeys("home"))
+
+	sortField, sortDir := config.GetSort()
 	return ListBooksModel{
-		index:    0, // Start with first item selected
-		offset:   0, // Start at top of list
-		pageSize: constants.BooksPerPage,
+		db:          db,
+		keys:        keys,
+		list:        l,
+		marked:      marked,
+		expanded:    -1,
+		sortField:   sortField,
+		sortDir:     sortDir,
+		notesLength: notesLength,
+		dateInput:   factory.CreateTextInput(validation.DateInputLayout, len(validation.DateInputLayout)),
 	}
 }
 
+// bookItem adapts a book to the bubbles list.Item interface.
+// It points into ListBooksModel.books so edits made on the detail
+// screen show up in the list without reloading.
+type bookItem struct {
+	book *models.Book
+}
+
+// filterNotesSeparator divides the title and author from the notes in a FilterValue.
+const filterNotesSeparator = "\x00"
+
+// FilterValue returns the text matched when filtering the list.
+// The notes follow filterNotesSeparator so filterBooks can match them separately.
+func (i bookItem) FilterValue() string {
+	return i.book.Title + " " + i.book.Author + filterNotesSeparator + i.book.Notes
+}
+
+// filterBooks ranks books for the list filter. Titles and authors match fuzzily,
+// ranked best first, and books whose notes contain the term, ignoring case,
+// follow in list order. Fuzzy matching is not used on notes, since almost any
+// long note contains the letters of a short term in order.
+func filterBooks(term string, targets []string) []list.Rank {
+	names := make([]string, len(targets))
+	for i, target := range targets {
+		names[i], _, _ = strings.Cut(target, filterNotesSeparator)
+	}
+	ranks := list.DefaultFilter(term, names)
+
+	matched := make(map[int]bool, len(ranks))
+	for _, rank := range ranks {
+		matched[rank.Index] = true
+	}
+	term = strings.ToLower(term)
+	for i, target := range targets {
+		_, notes, _ := strings.Cut(target, filterNotesSeparator)
+		if !matched[i] && strings.Contains(strings.ToLower(notes), term) {
+			ranks = append(ranks, list.Rank{Index: i})
+		}
+	}
+	return ranks
+}
+
+// bookDelegate renders each book as a card, matching the rest of the app.
+// Every card is padded to the same height, which the list needs for paging.
+type bookDelegate struct {
+	grouped     bool         // Whether to head each run of books with their shared location
+	height      int          // Lines taken up by each card
+	marked      map[int]bool // IDs of marked books, shared with ListBooksModel
+	notesLength int          // Characters of notes shown on each card
+	columns     int          // Columns each page is split into; 2 on wide terminals
+}
+
+// newBookDelegate creates a delegate whose height fits a card with every
+// field filled in, plus a line for the location heading when grouped.
+func newBookDelegate(grouped bool, marked map[int]bool, notesLength int) bookDelegate {
+	sample := models.Book{Title: "T", Author: "A", Type: models.Paperback, Location: "L", Notes: "N"}
+	height := lipgloss.Height(renderBookCard(sample, false, false, notesLength))
+	if grouped {
+		height++
+	}
+	return bookDelegate{grouped: grouped, height: height, marked: marked, notesLength: notesLength}
+}
+
+func (d bookDelegate) Height() int {
+	return d.height
+}
+
+func (d bookDelegate) Spacing() int {
+	return 0
+}
+
+func (d bookDelegate) Update(msg tea.Msg, m *list.Model) tea.Cmd {
+	return nil
+}
+
+// Render draws the card for the book at index, highlighted when selected.
+func (d bookDelegate) Render(w io.Writer, m list.Model, index int, item list.Item) {
+	bi, ok := item.(bookItem)
+	if !ok {
+		return
+	}
+	book := *bi.book
+
+	card := renderBookCard(book, index == m.Index(), d.marked[book.ID], d.notesLength)
+
+	// When grouped, head each run of books with their shared location
+	if d.grouped {
+		visible := m.VisibleItems()
+		start, _ := m.Paginator.GetSliceBounds(len(visible))
+		// The right column starts halfway through the page and needs its own heading
+		columnTop := index == start || (d.columns == 2 && index-start == columnRows(m))
+		heading := ""
+		if columnTop || visible[index-1].(bookItem).book.Location != book.Location {
+			heading = book.Location
+			if heading == "" {
+				heading = "No location"
+			}
+			heading = styles.FocusedStyle().Render(styles.AddLetterSpacing(heading))
+		}
+		card = heading + "\n" + card
+	}
+
+	fmt.Fprint(w, lipgloss.NewStyle().Height(d.height).Render(card))
+}
+
+// renderBookCard renders a book's title, author, type, date, location and
+// notes truncated to notesLength inside a container, using the selected styles if selected.
+// Marked books are ticked so it is clear which a bulk type change will affect.
+func renderBookCard(book models.Book, selected, marked bool, notesLength int) string {
+	titleStyle := styles.BookTitleUnselectedStyle()
+	valueStyle := styles.BookAuthorUnselectedStyle()
+	containerStyle := styles.BookContainerUnselectedStyle
+	if selected {
+		titleStyle = styles.BookTitleSelectedStyle()
+		valueStyle = styles.BookAuthorSelectedStyle()
+		containerStyle = styles.BookContainerSelectedStyle()
+	}
+	dateStr := utils.FormatDate(book.CreatedAt)
+	// Each format gets its own color so a mixed collection is quick to scan
+	typeStyle := lipgloss.NewStyle().Foreground(styles.TypeColor(book.Type)).Bold(selected).PaddingLeft(3)
+
+	// Pinned books are marked so it is clear why they sit at the top
+	title := styles.AddLetterSpacing(book.Title)
+	if book.Pinned {
+		title = "📌 " + title
+	}
+	if marked {
+		title = "✓ " + title
+	}
+	// Wishlist books are marked so they are not mistaken for owned copies
+	if !book.Owned {
+		title += "  " + styles.AddLetterSpacing("(wishlist)")
+	}
+	// Lent books say who has them, so they can be chased up
+	if book.LentTo != "" {
+		title += "  " + styles.AddLetterSpacing("(lent to "+lentLabel(book)+")")
+	}
+
+	var bookContent strings.Builder
+	bookContent.WriteString(titleStyle.Render(title))
+	bookContent.WriteString("\n\n")
+	bookContent.WriteString(fmt.Sprintf("%s%s", styles.SpacedBlurredStyle.Render(styles.AddLetterSpacing("Author:")), valueStyle.Render(styles.AddLetterSpacing(book.Author))))
+	bookContent.WriteString("\n\n")
+	bookContent.WriteString(fmt.Sprintf("%s%s   | %s%s", styles.SpacedBlurredStyle.Render(styles.AddLetterSpacing("Type:")), typeStyle.Render(styles.TypeLabel(book.Type)), styles.SpacedBlurredStyle.Render(styles.AddLetterSpacing("Added:")), valueStyle.Render(styles.AddLetterSpacing(dateStr))))
+	if book.Location != "" {
+		bookContent.WriteString("\n\n")
+		bookContent.WriteString(fmt.Sprintf("%s%s", styles.SpacedBlurredStyle.Render(styles.AddLetterSpacing("Location:")), valueStyle.Render(styles.AddLetterSpacing(book.Location))))
+	}
+	if book.Notes != "" {
+		// Show truncated notes
+		bookContent.WriteString("\n\n")
+		bookContent.WriteString(styles.SpacedNotesStyle.Render("\"" + styles.AddLetterSpacing(truncateNotes(book.Notes, notesLength)) + "\""))
+	}
+
+	return containerStyle.Render(bookContent.String())
+}
+
+// lentLabel names who a lent book is with and since when, e.g. "Ana since March 3rd, 2026"
+func lentLabel(book models.Book) string {
+	if book.LentDate == nil {
+		return book.LentTo
+	}
+	return book.LentTo + " since " + utils.FormatDate(*book.LentDate)
+}
 
 // truncateNotes shortens long note text for display in the book list.
 // It attempts to break at word boundaries to avoid cutting words in half,
@@ -67,7 +300,8 @@ func truncateNotes(notes string, maxLength int) string {
 }
 
 // Update handles user input and system messages for the book list screen.
-// It processes keyboard navigation, book selection, data loading, and deletion confirmations.
+// It processes book selection, grouping, data loading, and deletion confirmations,
+// and passes everything else to the bubbles list for navigation and filtering.
 // The function returns the updated model, any commands to execute, the next screen to show,
 // and optionally a selected book for the detail screen.
 //
@@ -82,31 +316,102 @@ func truncateNotes(notes string, maxLength int) string {
 func (m ListBooksModel) Update(msg tea.Msg) (ListBooksModel, tea.Cmd, models.Screen, *models.Book) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
-		switch msg.String() {
-		case "esc": // Return to main menu
+		key := msg.String()
+		// While typing a filter, every key belongs to the filter input,
+		// except that Enter offers a search with no matches as a new book
+		if m.list.SettingFilter() {
+			if query := m.unmatchedQuery(); query != "" && m.keys.Matches(keymap.Select, key) {
+				m.newTitle = query
+				m.list.ResetFilter()
+				return m, nil, models.AddBookScreen, nil
+			}
+			break
+		}
+		if m.retyping {
+			return m.updateRetyping(key)
+		}
+		if m.dating {
+			return m.updateDating(msg)
+		}
+		m.retyped = ""
+		switch {
+		case m.keys.Matches(keymap.Back, key):
+			// Back clears an applied filter first, then the date added filter,
+			// then any marks, then returns to the main menu
+			if m.list.FilterState() == list.FilterApplied {
+				m.list.ResetFilter()
+				return m, nil, models.ListBooksScreen, nil
+			}
+			if !m.cutoff.IsZero() {
+				m.cutoff = time.Time{}
+				m.expanded = -1
+				return m, m.refreshItems(), models.ListBooksScreen, nil
+			}
+			if len(m.marked) > 0 {
+				clear(m.marked)
+				return m, nil, models.ListBooksScreen, nil
+			}
 			return m, nil, models.MenuScreen, nil
-		case "up", "k": // Move selection up (arrow key or vim key)
-			if m.index > 0 {
-				m.index--
-				// Scroll up if selection moves above viewport
-				if m.index < m.offset {
-					m.offset = m.index
+		case key == "x": // Mark or unmark the selected book for a bulk type change
+			if item, ok := m.list.SelectedItem().(bookItem); ok {
+				if m.marked[item.book.ID] {
+					delete(m.marked, item.book.ID)
+				} else {
+					m.marked[item.book.ID] = true
 				}
 			}
-		case "down", "j": // Move selection down (arrow key or vim key)
-			if m.index < len(m.books)-1 {
-				m.index++
-				// Scroll down if selection moves below viewport
-				if m.index >= m.offset+m.pageSize {
-					m.offset = m.index - m.pageSize + 1
-				}
+			return m, nil, models.ListBooksScreen, nil
+		case key == "t": // Choose a new type for the marked books
+			if len(m.marked) > 0 {
+				m.retyping = true
+				m.newType = 0
+			}
+			return m, nil, models.ListBooksScreen, nil
+		case key == " ": // Expand or collapse the selected book's full notes
+			if m.expanded == m.list.Index() {
+				m.expanded = -1
+			} else if item, ok := m.list.SelectedItem().(bookItem); ok && item.book.Notes != "" {
+				m.expanded = m.list.Index()
 			}
-		case "enter": // Select current book for detailed view
-			if len(m.books) > 0 {
-				// Get reference to selected book and navigate to detail screen
-				selectedBook := &m.books[m.index]
-				return m, nil, models.BookDetailScreen, selectedBook
+			return m, nil, models.ListBooksScreen, nil
+		case key == "d": // Narrow the list to books added before or after a date
+			m.dating = true
+			m.dateErr = ""
+			m.dateAfter = m.cutoffAfter
+			m.dateInput.Reset()
+			if !m.cutoff.IsZero() {
+				m.dateInput.SetValue(m.cutoff.Format(validation.DateInputLayout))
 			}
+			return m, m.dateInput.Focus(), models.ListBooksScreen, nil
+		case key == "g": // Toggle grouping books by shelf location
+			m.expanded = -1
+			m.grouped = !m.grouped
+			m.resize()
+			cmd := m.refreshItems()
+			m.list.Select(0)
+			return m, cmd, models.ListBooksScreen, nil
+		case key == "s" || key == "S": // Cycle the sort field, or reverse the sort direction
+			if key == "s" {
+				m.sortField = nextSortField(m.sortField)
+			} else if m.sortDir == config.SortAsc {
+				m.sortDir = config.SortDesc
+			} else {
+				m.sortDir = config.SortAsc
+			}
+			// Remember the sort for next time; a failed save only affects later sessions
+			if err := config.UpdateSort(m.sortField, m.sortDir); err != nil {
+				m.err = err
+			}
+			m.expanded = -1
+			cmd := m.refreshItems()
+			m.list.Select(0)
+			return m, cmd, models.ListBooksScreen, nil
+		case m.keys.Matches(keymap.Select, key): // Select current book for detailed view
+			if item, ok := m.list.SelectedItem().(bookItem); ok {
+				// Pass reference to selected book and navigate to detail screen
+				return m, nil, models.BookDetailScreen, item.book
+			}
+			return m, nil, models.ListBooksScreen, nil
 		}
 
 	case messages.LoadBooksMsg: // Handle book data loaded from database
@@ -114,13 +419,58 @@ func (m ListBooksModel) Update(msg tea.Msg) (ListBooksModel, tea.Cmd, models.Scr
 			// Store error for display
 			m.err = msg.Err
 		} else {
-			// Update book list with loaded data
+			// A reload keeps the selected book, even if re-sorting moved it
+			selectedID := 0
+			if item, ok := m.list.SelectedItem().(bookItem); ok && msg.Reload {
+				selectedID = item.book.ID
+			}
+			// Update book list with loaded data; the list re-applies any active filter
 			m.books = msg.Books
-			// Ensure selected index is still valid after loading
-			if m.index >= len(m.books) && len(m.books) > 0 {
-				m.index = len(m.books) - 1
+			m.expanded = -1
+			clear(m.marked)
+			m.heading = msg.Heading
+			m.emptyText = msg.EmptyText
+			m.load = msg.Load
+			cmd := m.refreshItems()
+			switch {
+			case !msg.Reload:
+				// A fresh load, such as View Books from the menu, starts at the top
+				m.list.Select(0)
+			case m.selectBook(selectedID):
+			default:
+				// The book is gone, so stay at the same position, within the shorter list
+				if n := len(m.list.VisibleItems()); m.list.Index() >= n && n > 0 {
+					m.list.Select(n - 1)
+				}
+			}
+			return m, cmd, models.ListBooksScreen, nil
+		}
+		return m, nil, models.ListBooksScreen, nil
+
+	case messages.ReloadBooksMsg: // Handle a change made to the books from another screen
+		return m, m.reloadCmd(), models.ListBooksScreen, nil
+
+	case messages.TypeChangeMsg: // Handle the result of a bulk type change
+		if msg.Err != nil {
+			// Store error for display; nothing was changed, so the marks are kept
+			m.err = msg.Err
+			return m, nil, models.ListBooksScreen, nil
+		}
+		// Update the loaded books to match the database
+		changed := make(map[int]bool, len(msg.IDs))
+		for _, id := range msg.IDs {
+			changed[id] = true
+		}
+		for i := range m.books {
+			if changed[m.books[i].ID] {
+				m.books[i].Type = msg.Type
 			}
 		}
+		clear(m.marked)
+		m.err = nil
+		m.retyped = fmt.Sprintf("Changed %d book(s) to %s", len(msg.IDs), styles.CapitalizeBookType(string(msg.Type)))
+		m.dismissSeq++
+		return m, tea.Batch(m.refreshItems(), dismissCmd(models.ListBooksScreen, m.dismissSeq)), models.ListBooksScreen, nil
 
 	case messages.DeleteMsg: // Handle book deletion result
 		if msg.Err != nil {
@@ -129,13 +479,167 @@ func (m ListBooksModel) Update(msg tea.Msg) (ListBooksModel, tea.Cmd, models.Scr
 		} else {
 			// Set flag to show success message
 			m.deleted = true
+			m.dismissSeq++
+			return m, dismissCmd(models.ListBooksScreen, m.dismissSeq), models.ListBooksScreen, nil
 		}
+		return m, nil, models.ListBooksScreen, nil
+
+	case messages.DismissMsg:
+		// Hide the success messages once the latest one's timeout passes
+		if msg.Screen == models.ListBooksScreen && msg.Seq == m.dismissSeq {
+			m.ClearDeleted()
+		}
+		return m, nil, models.ListBooksScreen, nil
+	}
+
+	// The date input needs its cursor blinks
+	var cmd tea.Cmd
+	if m.dating {
+		m.dateInput, cmd = m.dateInput.Update(msg)
+		return m, cmd, models.ListBooksScreen, nil
+	}
+
+	// Navigation, paging and filtering are handled by the list
+	m.list, cmd = m.list.Update(msg)
+	// Moving the selection or changing the filter collapses expanded notes
+	if _, ok := msg.(tea.KeyMsg); ok && (m.list.Index() != m.expanded || m.list.SettingFilter()) {
+		m.expanded = -1
 	}
 
 	// Stay on list screen by default
+	return m, cmd, models.ListBooksScreen, nil
+}
+
+// unmatchedQuery returns the filter being typed when it matches no books,
+// or an empty string while there are matches or nothing has been typed.
+func (m ListBooksModel) unmatchedQuery() string {
+	query := strings.TrimSpace(m.list.FilterValue())
+	if query == "" || len(m.list.VisibleItems()) > 0 {
+		return ""
+	}
+	return query
+}
+
+// updateRetyping handles keys while the type selector for the marked books is shown.
+// The type is chosen with ←/→ or Tab, or directly with p/h/a/d, then applied with Enter.
+func (m ListBooksModel) updateRetyping(key string) (ListBooksModel, tea.Cmd, models.Screen, *models.Book) {
+	if index, ok := typeIndexForKey(models.BookTypes, key); ok {
+		m.newType = index
+		return m, nil, models.ListBooksScreen, nil
+	}
+	switch {
+	case key == "left" || key == "shift+tab":
+		m.newType = (m.newType + len(models.BookTypes) - 1) % len(models.BookTypes)
+	case key == "right" || key == "tab":
+		m.newType = (m.newType + 1) % len(models.BookTypes)
+	case m.keys.Matches(keymap.Select, key):
+		m.retyping = false
+		return m, m.changeTypeCmd(m.markedIDs(), models.BookTypes[m.newType]), models.ListBooksScreen, nil
+	case m.keys.Matches(keymap.Back, key):
+		m.retyping = false
+	}
 	return m, nil, models.ListBooksScreen, nil
 }
 
+// updateDating handles keys while the date added filter input is shown.
+// Tab switches between before and after, and Enter applies the typed date,
+// or turns the filter off when the input is blank. A date that does not
+// parse is reported under the input and leaves the list as it was.
+func (m ListBooksModel) updateDating(msg tea.KeyMsg) (ListBooksModel, tea.Cmd, models.Screen, *models.Book) {
+	key := msg.String()
+	switch {
+	case key == "tab" || key == "shift+tab":
+		m.dateAfter = !m.dateAfter
+		return m, nil, models.ListBooksScreen, nil
+	case m.keys.Matches(keymap.Select, key):
+		date, err := validation.ParseDateInput(m.dateInput.Value())
+		if err != nil {
+			m.dateErr = err.Error()
+			return m, nil, models.ListBooksScreen, nil
+		}
+		m.dating = false
+		m.dateInput.Blur()
+		m.cutoff = date
+		m.cutoffAfter = m.dateAfter
+		m.expanded = -1
+		cmd := m.refreshItems()
+		m.list.Select(0)
+		return m, cmd, models.ListBooksScreen, nil
+	case m.keys.Matches(keymap.Back, key):
+		// Keep whatever date filter was applied before
+		m.dating = false
+		m.dateInput.Blur()
+		return m, nil, models.ListBooksScreen, nil
+	}
+
+	var cmd tea.Cmd
+	m.dateInput, cmd = m.dateInput.Update(msg)
+	m.dateErr = ""
+	return m, cmd, models.ListBooksScreen, nil
+}
+
+// addedInRange reports whether a book passes the date added filter. Before a date
+// means added earlier than that day, and after a date means added on a later day,
+// so a book added on the cutoff day itself is left out either way.
+func (m ListBooksModel) addedInRange(book models.Book) bool {
+	if m.cutoff.IsZero() {
+		return true
+	}
+	if m.cutoffAfter {
+		return !book.CreatedAt.Before(m.cutoff.AddDate(0, 0, 1))
+	}
+	return book.CreatedAt.Before(m.cutoff)
+}
+
+// cutoffIndicator describes the date added filter for the status line, such as "Before 2024-03-15".
+func (m ListBooksModel) cutoffIndicator() string {
+	direction := "Before"
+	if m.cutoffAfter {
+		direction = "After"
+	}
+	return direction + " " + m.cutoff.Format(validation.DateInputLayout)
+}
+
+// markedIDs returns the IDs of the marked books in display order.
+func (m ListBooksModel) markedIDs() []int {
+	ids := make([]int, 0, len(m.marked))
+	for _, book := range m.books {
+		if m.marked[book.ID] {
+			ids = append(ids, book.ID)
+		}
+	}
+	return ids
+}
+
+// changeTypeCmd creates a command that changes the type of the given books
+// in a single transaction, reporting the result as a TypeChangeMsg.
+func (m ListBooksModel) changeTypeCmd(ids []int, bookType models.BookType) tea.Cmd {
+	return func() tea.Msg {
+		err := m.db.UpdateBooksType(ids, bookType)
+		return messages.TypeChangeMsg{IDs: ids, Type: bookType, Err: err}
+	}
+}
+
+// reloadCmd creates a command that loads the list's books again through the query
+// that last filled it, keeping its heading and empty text and the user's place.
+func (m ListBooksModel) reloadCmd() tea.Cmd {
+	load := m.load
+	if load == nil {
+		load = (*database.DB).LoadBooks
+	}
+	return func() tea.Msg {
+		books, err := load(m.db)
+		return messages.LoadBooksMsg{
+			Books:     books,
+			Heading:   m.heading,
+			EmptyText: m.emptyText,
+			Load:      m.load,
+			Reload:    true,
+			Err:       err,
+		}
+	}
+}
+
 // View renders the book list screen with all books and their details.
 // It displays each book's title, author, type, creation date, and truncated notes.
 // The currently selected book is highlighted, and the screen shows total count,
@@ -148,74 +652,108 @@ func (m ListBooksModel) View() string {
 
 	// Display application title and screen subtitle
 	b.WriteString("\n")
-	b.WriteString(styles.TitleStyle().Render("Ｌｉｂｒｏｓ　－　Ａ　Ｂｏｏｋ　Ｍａｎａｇｅｒ"))
+	b.WriteString(styles.TitleStyle().Render(styles.Label(i18n.T(i18n.AppTitle))))
 	b.WriteString("\n\n")
-	b.WriteString(styles.BlurredStyle.Render("Ｙｏｕｒ　Ｂｏｏｋ　Ｃｏｌｌｅｃｔｉｏｎ"))
+	if m.heading != "" {
+		b.WriteString(styles.BlurredStyle.Render(styles.Label(m.heading)))
+	} else {
+		b.WriteString(styles.BlurredStyle.Render(styles.Label("Ｙｏｕｒ　Ｂｏｏｋ　Ｃｏｌｌｅｃｔｉｏｎ")))
+	}
 	b.WriteString("\n\n")
 
 	if len(m.books) == 0 {
 		// Show empty state message when no books exist
-		b.WriteString(styles.BlurredStyle.Render("No books found. Add some books first!"))
+		if m.emptyText != "" {
+			b.WriteString(styles.BlurredStyle.Render(m.emptyText))
+		} else {
+			b.WriteString(styles.BlurredStyle.Render("No books found. Add some books first!"))
+		}
 	} else {
-		// Calculate visible books based on current offset and page size
-		endIndex := m.offset + m.pageSize
-		if endIndex > len(m.books) {
-			endIndex = len(m.books)
-		}
-
-		// Display only visible books
-		for i := m.offset; i < endIndex; i++ {
-			book := m.books[i]
-			dateStr := utils.FormatDate(book.CreatedAt)
-
-			// Create book content with enhanced styling
-			var bookContent strings.Builder
-
-			if i == m.index {
-				// Currently selected book - use enhanced selected styles
-				bookContent.WriteString(styles.BookTitleSelectedStyle().Render(styles.AddLetterSpacing(book.Title)))
-				bookContent.WriteString("\n\n")
-				bookContent.WriteString(fmt.Sprintf("%s%s", styles.SpacedBlurredStyle.Render(styles.AddLetterSpacing("Author:")), styles.BookAuthorSelectedStyle().Render(styles.AddLetterSpacing(book.Author))))
-				bookContent.WriteString("\n\n")
-				bookContent.WriteString(fmt.Sprintf("%s%s   | %s%s", styles.SpacedBlurredStyle.Render(styles.AddLetterSpacing("Type:")), styles.BookAuthorSelectedStyle().Render(styles.AddLetterSpacing(styles.CapitalizeBookType(string(book.Type)))), styles.SpacedBlurredStyle.Render(styles.AddLetterSpacing("Added:")), styles.BookAuthorSelectedStyle().Render(styles.AddLetterSpacing(dateStr))))
-				if book.Notes != "" {
-					// Show truncated notes for selected book
-					bookContent.WriteString("\n\n")
-					bookContent.WriteString(styles.SpacedNotesStyle.Render("\"" + styles.AddLetterSpacing(truncateNotes(book.Notes, constants.TextWrapWidth)) + "\""))
-				}
+		l := m.list
+		notes := m.expandedNotes()
+		if notes != "" {
+			// Shrink the list so the expanded notes fit below it; two columns
+			// give up whole rows so both columns stay the same length
+			shrink := lipgloss.Height(notes)
+			if m.columns() == 2 {
+				cardHeight := m.delegate().Height()
+				shrink = 2 * cardHeight * ((shrink + cardHeight - 1) / cardHeight)
+			}
+			l.SetHeight(max(l.Height()-shrink, 1))
+		}
+		if m.columns() == 2 {
+			b.WriteString(m.columnsView(l))
+		} else {
+			b.WriteString(l.View())
+		}
+		if notes != "" {
+			b.WriteString("\n" + notes + "\n")
+		}
 
-				// Wrap selected book in container
-				b.WriteString(styles.BookContainerSelectedStyle().Render(bookContent.String()))
-			} else {
-				// Non-selected book - use enhanced unselected styles
-				bookContent.WriteString(styles.BookTitleUnselectedStyle().Render(styles.AddLetterSpacing(book.Title)))
-				bookContent.WriteString("\n\n")
-				bookContent.WriteString(fmt.Sprintf("%s%s", styles.SpacedBlurredStyle.Render(styles.AddLetterSpacing("Author:")), styles.BookAuthorUnselectedStyle().Render(styles.AddLetterSpacing(book.Author))))
-				bookContent.WriteString("\n\n")
-				bookContent.WriteString(fmt.Sprintf("%s%s   | %s%s", styles.SpacedBlurredStyle.Render(styles.AddLetterSpacing("Type:")), styles.BookAuthorUnselectedStyle().Render(styles.AddLetterSpacing(styles.CapitalizeBookType(string(book.Type)))), styles.SpacedBlurredStyle.Render(styles.AddLetterSpacing("Added:")), styles.BookAuthorUnselectedStyle().Render(styles.AddLetterSpacing(dateStr))))
-				if book.Notes != "" {
-					// Show truncated notes for non-selected book too
-					bookContent.WriteString("\n\n")
-					bookContent.WriteString(styles.SpacedNotesStyle.Render("\"" + styles.AddLetterSpacing(truncateNotes(book.Notes, constants.TextWrapWidth)) + "\""))
-				}
+		// Display total book count, any filter matches and the page position
+		b.WriteString("\n")
+		status := fmt.Sprintf("   %s %d", styles.AddLetterSpacing("Total books:"), len(m.books))
+		if !m.cutoff.IsZero() {
+			status += fmt.Sprintf("  |  %s %s", styles.AddLetterSpacing("Added:"), styles.AddLetterSpacing(m.cutoffIndicator()))
+		}
+		if m.list.FilterState() != list.Unfiltered || !m.cutoff.IsZero() {
+			status += fmt.Sprintf("  |  %s %d", styles.AddLetterSpacing("Matching:"), len(m.list.VisibleItems()))
+		}
+		status += fmt.Sprintf("  |  %s %d/%d", styles.AddLetterSpacing("Page:"), m.list.Paginator.Page+1, max(m.list.Paginator.TotalPages, 1))
+		status += fmt.Sprintf("  |  %s %s", styles.AddLetterSpacing("Sort:"), sortIndicator(m.sortField, m.sortDir))
+		if len(m.marked) > 0 {
+			status += fmt.Sprintf("  |  %s %d", styles.AddLetterSpacing("Marked:"), len(m.marked))
+		}
+		b.WriteString(styles.BlurredStyle.Render(status))
+		b.WriteString("\n")
+	}
 
-				// Wrap unselected book in subtle container
-				b.WriteString(styles.BookContainerUnselectedStyle.Render(bookContent.String()))
+	// Show the type selector for the marked books
+	if m.retyping {
+		b.WriteString("\n")
+		b.WriteString(styles.FocusedStyle().Render(styles.AddLetterSpacing(fmt.Sprintf("Change %d marked book(s) to:", len(m.marked)))))
+		b.WriteString("\n\n   ")
+		for i, bookType := range models.BookTypes {
+			buttonText := fmt.Sprintf("  %s  ", styles.AddLetterSpacing(styles.CapitalizeBookType(string(bookType))))
+			if i == m.newType {
+				b.WriteString(styles.BookTypeSelectedStyle().Render(buttonText))
+			} else {
+				b.WriteString(styles.SpacedBlurredStyle.Render(buttonText))
+			}
+			if i < len(models.BookTypes)-1 {
+				b.WriteString("  ")
 			}
+		}
+		b.WriteString("\n")
+	}
 
-			// Add minimal spacing between books
-			if i < endIndex-1 {
-				b.WriteString("\n")
+	// Show the date added filter input, with the reason the last date was refused
+	if m.dating {
+		b.WriteString("\n")
+		b.WriteString(styles.FocusedStyle().Render(styles.AddLetterSpacing("Show books added:")))
+		b.WriteString("\n\n   ")
+		for i, label := range []string{"Before", "After"} {
+			buttonText := fmt.Sprintf("  %s  ", styles.AddLetterSpacing(label))
+			if (i == 1) == m.dateAfter {
+				b.WriteString(styles.BookTypeSelectedStyle().Render(buttonText))
+			} else {
+				b.WriteString(styles.SpacedBlurredStyle.Render(buttonText))
 			}
+			b.WriteString("  ")
+		}
+		b.WriteString("\n\n")
+		b.WriteString(m.dateInput.View())
+		b.WriteString("\n")
+		if m.dateErr != "" {
+			b.WriteString(styles.ErrorStyle.Render(styles.AddLetterSpacing("Error: " + m.dateErr)))
+			b.WriteString("\n")
 		}
+	}
 
-		// Display total book count and scroll position
+	// Show confirmation of a bulk type change
+	if m.retyped != "" {
 		b.WriteString("\n")
-		currentPage := (m.index / m.pageSize) + 1
-		totalPages := (len(m.books) + m.pageSize - 1) / m.pageSize
-		b.WriteString(styles.BlurredStyle.Render(fmt.Sprintf("   %s %d  |  %s %d/%d",
-			styles.AddLetterSpacing("Total books:"), len(m.books),
-			styles.AddLetterSpacing("Page:"), currentPage, totalPages)))
+		b.WriteString(styles.SuccessStyle.Render(styles.AddLetterSpacing(m.retyped)))
 		b.WriteString("\n")
 	}
 
@@ -233,19 +771,287 @@ func (m ListBooksModel) View() string {
 		b.WriteString("\n")
 	}
 
-	// Display appropriate help text based on whether books exist
-	if len(m.books) > 0 {
-		b.WriteString("\n\n" + styles.HelpTextStyle.Render("   "+styles.AddLetterSpacing("Use ↑/↓ or j/k to navigate, Enter to select, Esc to return to menu, q to quit")))
-	} else {
-		b.WriteString("\n\n" + styles.HelpTextStyle.Render("   "+styles.AddLetterSpacing("Press Esc to return to menu, q or Ctrl+C to quit")))
+	// Display appropriate help text based on whether books exist and the filter state
+	switch {
+	case len(m.books) == 0:
+		b.WriteString("\n\n   " + renderHelp(help.ListEmpty))
+	case m.retyping:
+		b.WriteString("\n\n   " + renderHelp(help.ListRetype))
+	case m.dating:
+		b.WriteString("\n\n   " + renderHelp(help.ListDate))
+	case m.list.SettingFilter() && m.unmatchedQuery() != "":
+		b.WriteString("\n\n" + styles.FocusedStyle().Render(styles.AddLetterSpacing(fmt.Sprintf("No books match. Press Enter to add \"%s\" as a new book", m.unmatchedQuery()))))
+		b.WriteString("\n\n   " + renderHelp(help.ListFilterNoMatch))
+	case m.list.SettingFilter():
+		b.WriteString("\n\n   " + renderHelp(help.ListFilter))
+	case m.list.FilterState() == list.FilterApplied:
+		b.WriteString("\n\n   " + renderHelp(help.ListFiltered))
+	case !m.cutoff.IsZero():
+		b.WriteString("\n\n   " + renderHelp(help.ListDated))
+	default:
+		b.WriteString("\n\n   " + renderHelp(help.List))
 	}
 
 	return b.String()
 }
 
-// ClearDeleted resets the deleted flag to hide the success message.
-// This is typically called when navigating away from the list screen
-// to ensure the success message doesn't persist across screen transitions.
+// expandedNotes renders the full notes of the expanded book, wrapped to fit
+// the terminal, or returns an empty string when no notes are expanded.
+func (m ListBooksModel) expandedNotes() string {
+	item, ok := m.list.SelectedItem().(bookItem)
+	if m.expanded < 0 || m.expanded != m.list.Index() || !ok {
+		return ""
+	}
+	// Letter spacing roughly doubles the width of the text, and the style adds padding
+	width := constants.TextWrapWidth
+	if m.width > 0 {
+		width = min(width, max((m.width-8)/2, 20))
+	}
+	heading := styles.SpacedBlurredStyle.Render(styles.AddLetterSpacing("Notes for " + item.book.Title + ":"))
+	notes := styles.SpacedNotesStyle.Render("\"" + styles.AddLetterSpacing(utils.WrapText(item.book.Notes, width)) + "\"")
+	return heading + "\n\n" + notes
+}
+
+// sortBooks orders the list for display: pinned books first, then by the
+// chosen sort field and direction, or grouped by location (alphabetically,
+// with books that have no location last) and sorted within each location.
+// Pinned books follow the same order among themselves, and ties fall back
+// to newest first.
+func (m *ListBooksModel) sortBooks() {
+	sort.SliceStable(m.books, func(i, j int) bool {
+		a, b := m.books[i], m.books[j]
+		if a.Pinned != b.Pinned {
+			return a.Pinned
+		}
+		if m.grouped && a.Location != b.Location {
+			if a.Location == "" || b.Location == "" {
+				return b.Location == ""
+			}
+			return strings.ToLower(a.Location) < strings.ToLower(b.Location)
+		}
+		if c := compareBooks(a, b, m.sortField); c != 0 {
+			if m.sortDir == config.SortAsc {
+				return c < 0
+			}
+			return c > 0
+		}
+		if !a.CreatedAt.Equal(b.CreatedAt) {
+			return a.CreatedAt.After(b.CreatedAt)
+		}
+		return a.ID > b.ID
+	})
+}
+
+// compareBooks compares two books by a sort field, ignoring case for text,
+// returning a negative number when a sorts first in ascending order.
+func compareBooks(a, b models.Book, field string) int {
+	switch field {
+	case config.SortTitle:
+		return strings.Compare(strings.ToLower(a.Title), strings.ToLower(b.Title))
+	case config.SortAuthor:
+		return strings.Compare(strings.ToLower(a.Author), strings.ToLower(b.Author))
+	default:
+		return a.CreatedAt.Compare(b.CreatedAt)
+	}
+}
+
+// nextSortField returns the sort field after field, wrapping back to the first.
+func nextSortField(field string) string {
+	for i, name := range config.SortFields {
+		if name == field {
+			return config.SortFields[(i+1)%len(config.SortFields)]
+		}
+	}
+	return config.SortFields[0]
+}
+
+// sortIndicator describes a sort for the status line, such as "Title ↑".
+func sortIndicator(field, dir string) string {
+	arrow := "↓"
+	if dir == config.SortAsc {
+		arrow = "↑"
+	}
+	name := map[string]string{
+		config.SortAdded:  "Added",
+		config.SortTitle:  "Title",
+		config.SortAuthor: "Author",
+	}[field]
+	return name + " " + arrow
+}
+
+// refreshItems sorts the books and rebuilds the list items from them,
+// leaving out books outside the date added filter.
+// Items point into the books slice, so they must be rebuilt after every sort.
+// The returned command re-applies any active filter to the new items.
+func (m *ListBooksModel) refreshItems() tea.Cmd {
+	m.sortBooks()
+	items := make([]list.Item, 0, len(m.books))
+	for i := range m.books {
+		if m.addedInRange(m.books[i]) {
+			items = append(items, bookItem{book: &m.books[i]})
+		}
+	}
+	return m.list.SetItems(items)
+}
+
+// Books returns the books currently shown in the list, in display order.
+// When a filter is applied only the matching books are returned.
+// The detail screen uses this to step through the collection with next/previous.
+func (m ListBooksModel) Books() []*models.Book {
+	visible := m.list.VisibleItems()
+	books := make([]*models.Book, 0, len(visible))
+	for _, item := range visible {
+		books = append(books, item.(bookItem).book)
+	}
+	return books
+}
+
+// selectBook selects the visible book with the given ID, reporting whether it was found.
+func (m *ListBooksModel) selectBook(id int) bool {
+	for i, item := range m.list.VisibleItems() {
+		if item.(bookItem).book.ID == id {
+			m.list.Select(i)
+			return true
+		}
+	}
+	return false
+}
+
+// Index returns the position of the currently selected book among the visible books.
+func (m ListBooksModel) Index() int {
+	return m.list.Index()
+}
+
+// SetIndex moves the selection to the given position and scrolls it into view.
+// This keeps the list in step with the book last shown on the detail screen.
+//
+// Parameters:
+//   - index: Position of the book to select (ignored if out of range)
+func (m *ListBooksModel) SetIndex(index int) {
+	if index < 0 || index >= len(m.list.VisibleItems()) {
+		return
+	}
+	m.list.Select(index)
+}
+
+// SetSize fits the list to the terminal, leaving room for the header,
+// counts and help text drawn around it.
+//
+// Parameters:
+//   - width: Terminal width in columns
+//   - height: Terminal height in lines
+func (m *ListBooksModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+	m.resize()
+}
+
+// resize fits the list and its card delegate to the terminal size and grouping.
+// From constants.TwoColumnMinWidth the list is sized to hold two columns of
+// cards per page, which View splits side by side.
+func (m *ListBooksModel) resize() {
+	delegate := m.delegate()
+	m.list.SetDelegate(delegate)
+	if m.width == 0 {
+		// The terminal size is not known yet
+		return
+	}
+
+	height := max(m.height-constants.ListChromeHeight, 1)
+	if delegate.columns == 1 {
+		m.list.SetSize(m.width, height)
+		return
+	}
+	titleHeight := lipgloss.Height(m.list.Styles.TitleBar.Render(""))
+	rows := max((height-titleHeight)/delegate.Height(), 1)
+	m.list.SetSize(m.width/2, titleHeight+2*rows*delegate.Height())
+}
+
+// delegate creates the card delegate for the current grouping and layout.
+// In two columns the notes are shortened to fit half the terminal.
+func (m ListBooksModel) delegate() bookDelegate {
+	notesLength := m.notesLength
+	columns := m.columns()
+	if columns == 2 {
+		// Letter spacing doubles the width of the notes, and the card adds padding and a border
+		notesLength = min(notesLength, max((m.width/2-16)/2, constants.ListNotesMinLength))
+	}
+	delegate := newBookDelegate(m.grouped, m.marked, notesLength)
+	delegate.columns = columns
+	return delegate
+}
+
+// columns returns how many columns of cards the list shows: two on terminals
+// at least constants.TwoColumnMinWidth wide, otherwise one.
+func (m ListBooksModel) columns() int {
+	if m.width >= constants.TwoColumnMinWidth {
+		return 2
+	}
+	return 1
+}
+
+// columnRows returns how many cards fill the left column of a two-column page;
+// the right column takes the rest.
+func columnRows(l list.Model) int {
+	return (l.Paginator.PerPage + 1) / 2
+}
+
+// columnsView renders the current page of l as two columns of cards. The page
+// runs down the left column and continues at the top of the right one, so ↑/↓
+// move within a column and from the bottom of the left column to the top of the right.
+func (m ListBooksModel) columnsView(l list.Model) string {
+	delegate := m.delegate()
+	visible := l.VisibleItems()
+	start, end := l.Paginator.GetSliceBounds(len(visible))
+	rows := columnRows(l)
+
+	// Long lines are cut at the column edge so every card keeps its height
+	width := m.width / 2
+	cut := lipgloss.NewStyle().MaxWidth(width)
+	pad := lipgloss.NewStyle().Width(width)
+	var columns [2][]string
+	for i := start; i < end; i++ {
+		var card strings.Builder
+		delegate.Render(&card, l, i, visible[i])
+		column := (i - start) / rows
+		columns[column] = append(columns[column], pad.Render(cut.Render(card.String())))
+	}
+
+	// Draw the filter input where the single-column list would
+	titleBar := l.Styles.TitleBar.Render("")
+	if l.SettingFilter() {
+		titleBar = l.Styles.TitleBar.Render(l.FilterInput.View())
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, titleBar, lipgloss.JoinHorizontal(lipgloss.Top,
+		lipgloss.JoinVertical(lipgloss.Left, columns[0]...),
+		lipgloss.JoinVertical(lipgloss.Left, columns[1]...)))
+}
+
+// NewTitle returns the search that found no books when the user chose
+// to add it as a new book, so the add screen can start with it as the title.
+func (m ListBooksModel) NewTitle() string {
+	return m.newTitle
+}
+
+// Filtering reports whether the user is typing a filter or a date to filter by,
+// so global keys such as q can be left to the input.
+func (m ListBooksModel) Filtering() bool {
+	return m.list.SettingFilter() || m.dating
+}
+
+// ResetFilter clears any filter, including the date added filter, so every
+// loaded book is listed again once the books are next loaded.
+func (m *ListBooksModel) ResetFilter() {
+	m.list.ResetFilter()
+	m.dating = false
+	m.dateInput.Blur()
+	m.cutoff = time.Time{}
+}
+
+// ClearDeleted resets the deleted flag and any bulk type change confirmation
+// to hide the success messages. This is typically called when navigating away
+// from the list screen to ensure they don't persist across screen transitions.
 func (m *ListBooksModel) ClearDeleted() {
 	m.deleted = false
+	m.retyped = ""
 }
@@ -0,0 +1,192 @@
+package screens
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/papadavis47/libros/internal/database"
+	"github.com/papadavis47/libros/internal/messages"
+	"github.com/papadavis47/libros/internal/models"
+)
+
+// openTestDB creates a database in a temporary directory, with HOME pointed
+// there too so the screens never read or write the real ~/.libros config
+func openTestDB(t *testing.T) *database.DB {
+	t.Helper()
+
+	tempDir := t.TempDir()
+	t.Setenv("HOME", tempDir)
+
+	db, err := database.New(filepath.Join(tempDir, "test.db"))
+	if err != nil {
+		t.Fatalf("Failed to create database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	return db
+}
+
+// saveBooks adds books with the given titles and returns them, keyed by title
+func saveBooks(t *testing.T, db *database.DB, titles ...string) map[string]models.Book {
+	t.Helper()
+
+	for _, title := range titles {
+		if err := db.SaveBook(title, "Author", models.Paperback, "", ""); err != nil {
+			t.Fatalf("SaveBook() returned error: %v", err)
+		}
+	}
+	books, err := db.LoadBooks()
+	if err != nil {
+		t.Fatalf("Failed to load books: %v", err)
+	}
+	byTitle := make(map[string]models.Book, len(books))
+	for _, book := range books {
+		byTitle[book.Title] = book
+	}
+	return byTitle
+}
+
+// runCmd runs cmd and returns its message, failing the test if there is none
+func runCmd(t *testing.T, cmd tea.Cmd) tea.Msg {
+	t.Helper()
+
+	if cmd == nil {
+		t.Fatal("Expected a command, got nil")
+	}
+	return cmd()
+}
+
+// TestListBooks_ReloadKeepsSubset tests that a book list filled from the wishlist
+// reloads the wishlist, with its heading, after a book's details change it
+func TestListBooks_ReloadKeepsSubset(t *testing.T) {
+	db := openTestDB(t)
+	books := saveBooks(t, db, "Owned Book", "Wanted Book", "Another Wanted Book")
+	for _, title := range []string{"Wanted Book", "Another Wanted Book"} {
+		if err := db.SetOwned(books[title].ID, false); err != nil {
+			t.Fatalf("SetOwned() returned error: %v", err)
+		}
+	}
+
+	list := NewListBooksModel(db)
+	utilities := NewUtilitiesModel(db)
+	list, _, _, _ = list.Update(runCmd(t, utilities.loadWishlistCmd("Wishlist")))
+	if len(list.Books()) != 2 {
+		t.Fatalf("Wishlist shows %d books, want 2", len(list.Books()))
+	}
+
+	// Mark a wishlist book as owned on its details, then go back to the list
+	if err := db.SetOwned(books["Wanted Book"].ID, true); err != nil {
+		t.Fatalf("SetOwned() returned error: %v", err)
+	}
+	detail := NewDetailModel(db)
+	detail.SetBooks(list.Books(), 0)
+	detail, _, _ = detail.Update(messages.OwnedMsg{Owned: true})
+	_, cmd, screen := detail.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if screen != models.ListBooksScreen {
+		t.Fatalf("Esc went to screen %v, want the book list", screen)
+	}
+
+	reload := runCmd(t, cmd)
+	if _, ok := reload.(messages.ReloadBooksMsg); !ok {
+		t.Fatalf("Going back sent %T, want messages.ReloadBooksMsg", reload)
+	}
+	list, cmd, _, _ = list.Update(reload)
+	list, _, _, _ = list.Update(runCmd(t, cmd))
+
+	listed := list.Books()
+	if len(listed) != 1 || listed[0].Title != "Another Wanted Book" {
+		t.Errorf("Reloaded list = %d books, want only Another Wanted Book", len(listed))
+	}
+	if list.heading != "Wishlist" {
+		t.Errorf("Reloaded heading = %q, want %q", list.heading, "Wishlist")
+	}
+	if list.load == nil || list.emptyText == "" {
+		t.Error("Reloaded list should keep the wishlist query and empty text")
+	}
+}
+
+// TestListBooks_ReloadWholeCollection tests that a list filled without a query
+// reloads the whole collection
+func TestListBooks_ReloadWholeCollection(t *testing.T) {
+	db := openTestDB(t)
+	saveBooks(t, db, "First Book")
+
+	list := NewListBooksModel(db)
+	all, err := db.LoadBooks()
+	if err != nil {
+		t.Fatalf("Failed to load books: %v", err)
+	}
+	list, _, _, _ = list.Update(messages.LoadBooksMsg{Books: all})
+
+	saveBooks(t, db, "Second Book")
+	list, cmd, _, _ := list.Update(messages.ReloadBooksMsg{})
+	list, _, _, _ = list.Update(runCmd(t, cmd))
+
+	if len(list.Books()) != 2 {
+		t.Errorf("Reloaded list = %d books, want 2", len(list.Books()))
+	}
+	if list.heading != "" {
+		t.Errorf("Reloaded heading = %q, want none", list.heading)
+	}
+}
+
+// TestListBooks_ReloadLentBooks tests that after a book is returned from the
+// Lent Out list, going back shows only the books still lent out
+func TestListBooks_ReloadLentBooks(t *testing.T) {
+	db := openTestDB(t)
+	books := saveBooks(t, db, "Shelved Book", "Lent Book", "Returned Book")
+	for _, title := range []string{"Lent Book", "Returned Book"} {
+		if err := db.LendBook(books[title].ID, "Sam"); err != nil {
+			t.Fatalf("LendBook() returned error: %v", err)
+		}
+	}
+
+	list := NewListBooksModel(db)
+	utilities := NewUtilitiesModel(db)
+	list, _, _, _ = list.Update(runCmd(t, utilities.loadLentCmd("Lent Out")))
+
+	if err := db.ReturnBook(books["Returned Book"].ID); err != nil {
+		t.Fatalf("ReturnBook() returned error: %v", err)
+	}
+	list, cmd, _, _ := list.Update(messages.ReloadBooksMsg{})
+	list, _, _, _ = list.Update(runCmd(t, cmd))
+
+	listed := list.Books()
+	if len(listed) != 1 || listed[0].Title != "Lent Book" {
+		t.Errorf("Reloaded list = %d books, want only Lent Book", len(listed))
+	}
+	if list.heading != "Lent Out" {
+		t.Errorf("Reloaded heading = %q, want %q", list.heading, "Lent Out")
+	}
+}
+
+// TestListBooks_ReloadAddedSince tests that a period view such as Added This Week
+// stays that period, with its empty text, when reloaded after a deletion
+func TestListBooks_ReloadAddedSince(t *testing.T) {
+	db := openTestDB(t)
+	books := saveBooks(t, db, "New Book")
+
+	list := NewListBooksModel(db)
+	utilities := NewUtilitiesModel(db)
+	start := time.Now().Add(-time.Hour)
+	list, _, _, _ = list.Update(runCmd(t, utilities.loadAddedSinceCmd(start, "Added This Week")))
+
+	// A book outside the period, and the deletion of the only book in it
+	if _, err := db.ImportBooks([]models.Book{{Title: "Old Book", Author: "Author", Type: models.Paperback, CreatedAt: start.AddDate(-1, 0, 0)}}); err != nil {
+		t.Fatalf("ImportBooks() returned error: %v", err)
+	}
+	if err := db.DeleteBook(books["New Book"].ID); err != nil {
+		t.Fatalf("DeleteBook() returned error: %v", err)
+	}
+	list, cmd, _, _ := list.Update(messages.ReloadBooksMsg{})
+	list, _, _, _ = list.Update(runCmd(t, cmd))
+
+	if len(list.Books()) != 0 {
+		t.Errorf("Reloaded list = %d books, want none added in the period", len(list.Books()))
+	}
+	if list.heading != "Added This Week" || list.emptyText != "No books added in this period." {
+		t.Errorf("Reloaded heading %q and empty text %q should be kept", list.heading, list.emptyText)
+	}
+}
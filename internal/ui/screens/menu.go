@@ -5,10 +5,16 @@
 package screens
 
 import (
+	"fmt"
+	"path/filepath"
 	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/papadavis47/libros/internal/config"
 	"github.com/papadavis47/libros/internal/database"
+	"github.com/papadavis47/libros/internal/help"
+	"github.com/papadavis47/libros/internal/i18n"
+	"github.com/papadavis47/libros/internal/keymap"
 	"github.com/papadavis47/libros/internal/messages"
 	"github.com/papadavis47/libros/internal/models"
 	"github.com/papadavis47/libros/internal/styles"
@@ -17,9 +23,18 @@ import (
 // MenuModel represents the main menu screen of the application.
 // It provides navigation options based on the current state of the book collection.
 type MenuModel struct {
-	db    *database.DB // Database connection for checking book count and loading books
-	items []string     // Menu items to display (dynamically generated based on book count)
-	index int          // Currently selected menu item index (0-based)
+	db    *database.DB  // Database connection for checking book count and loading books
+	keys  keymap.KeyMap // Key bindings for navigation and selection
+	items []string      // Names of the menu entries to display (dynamically generated based on book count)
+	index int           // Currently selected menu item index (0-based)
+
+	reading  *models.Book // Book currently being read, shown above the options; nil if none
+	wishlist int          // Number of books on the wishlist, shown above the options when non-zero
+	count    int          // Number of books in the collection, shown in the footer summary
+
+	configErr      error  // Problem reading the config file, shown once until dismissed or reset
+	configStatus   string // Result of resetting the config, shown until the next key press
+	configResetErr bool   // Whether configStatus describes a failed reset
 }
 
 // NewMenuModel creates and initializes a new MenuModel instance.
@@ -33,32 +48,59 @@ type MenuModel struct {
 //   - MenuModel: Fully initialized menu model ready for use
 func NewMenuModel(db *database.DB) MenuModel {
 	m := MenuModel{
-		db:    db,
-		index: 0,
+		db:        db,
+		keys:      keymap.Load(),
+		index:     0,
+		configErr: config.CheckConfig(), // Surface a corrupt config file once at startup
 	}
 	// Generate initial menu items based on current book count
 	m.updateMenuItems()
 	return m
 }
 
+// menuLabels maps the entry names used in the menu setting to the keys of their display text
+var menuLabels = map[string]i18n.Key{
+	config.MenuAdd:       i18n.MenuAdd,
+	config.MenuView:      i18n.MenuView,
+	config.MenuUtilities: i18n.MenuUtilities,
+	config.MenuTheme:     i18n.MenuTheme,
+	config.MenuQuit:      i18n.MenuQuit,
+}
+
 // updateMenuItems dynamically generates menu options based on the current book count.
-// If books exist in the collection, it shows "View Books" option; otherwise, it hides it.
+// Entries come from the configured menu layout, in its order. If books exist in the
+// collection, it shows "View Books"; otherwise, it hides it.
 // This prevents users from trying to view an empty collection and provides a cleaner UX.
+// Utilities stays available, so an empty library can import books or switch to another.
 func (m *MenuModel) updateMenuItems() {
 	// Get current book count to determine available menu options
+	// On database error, treat the collection as empty to provide minimal menu options
 	count, err := m.db.GetBookCount()
-	if err != nil {
-		// On database error, provide minimal menu options
-		m.items = []string{"Ａｄｄ　Ｂｏｏｋ", "Ｔｈｅｍｅ", "Ｑｕｉｔ"}
-		return
+	hasBooks := err == nil && count > 0
+
+	layout := config.GetMenu()
+	items := make([]string, 0, len(layout))
+	for _, name := range layout {
+		// View Books needs books to work with
+		if !hasBooks && name == config.MenuView {
+			continue
+		}
+		items = append(items, name)
 	}
+	m.items = items
 
-	if count > 0 {
-		// Books exist - show all menu options including View Books and Utilities
-		m.items = []string{"Ａｄｄ　Ｂｏｏｋ", "Ｖｉｅｗ　Ｂｏｏｋｓ", "Ｕｔｉｌｉｔｉｅｓ", "Ｔｈｅｍｅ", "Ｑｕｉｔ"}
-	} else {
-		// No books exist - hide View Books and Utilities options
-		m.items = []string{"Ａｄｄ　Ｂｏｏｋ", "Ｔｈｅｍｅ", "Ｑｕｉｔ"}
+	// Look up the currently reading book for the reminder line; skip it on error
+	m.reading = nil
+	m.wishlist = 0
+	m.count = 0
+	if hasBooks {
+		m.count = count
+		if book, err := m.db.CurrentlyReading(); err == nil {
+			m.reading = book
+		}
+		if count, err := m.db.GetWishlistCount(); err == nil {
+			m.wishlist = count
+		}
 	}
 
 	// Ensure selected index is still valid after menu items change
@@ -80,32 +122,50 @@ func (m *MenuModel) updateMenuItems() {
 //   - tea.Cmd: Command to execute (if any)
 //   - models.Screen: Next screen to display
 func (m MenuModel) Update(msg tea.KeyMsg) (MenuModel, tea.Cmd, models.Screen) {
-	switch msg.String() {
-	case "up", "k": // Move selection up (arrow key or vim key)
+	key := msg.String()
+	m.configStatus = ""
+	m.configResetErr = false
+
+	// While the config notice is shown, r resets the file and any other key dismisses it
+	if m.configErr != nil {
+		if key == "r" {
+			if err := config.ResetConfig(); err != nil {
+				m.configStatus = fmt.Sprintf(i18n.T(i18n.MenuConfigResetFailed), err)
+				m.configResetErr = true
+			} else {
+				m.configStatus = i18n.T(i18n.MenuConfigReset)
+			}
+		}
+		m.configErr = nil
+		return m, nil, models.MenuScreen
+	}
+	switch {
+	case m.keys.Matches(keymap.Up, key): // Move selection up (arrow key or vim key by default)
 		if m.index > 0 {
 			m.index--
 		}
-	case "down", "j": // Move selection down (arrow key or vim key)
+	case m.keys.Matches(keymap.Down, key): // Move selection down (arrow key or vim key by default)
 		if m.index < len(m.items)-1 {
 			m.index++
 		}
-	case "enter": // Activate selected menu item
+	case m.keys.Matches(keymap.Select, key): // Activate selected menu item
+		// Entries are matched by name, so a translated label still selects the same screen
 		selectedItem := m.items[m.index]
 		switch selectedItem {
-		case "Ａｄｄ　Ｂｏｏｋ":
+		case config.MenuAdd:
 			// Navigate to book creation screen
 			return m, nil, models.AddBookScreen
-		case "Ｖｉｅｗ　Ｂｏｏｋｓ":
+		case config.MenuView:
 			// Load books from database and navigate to list screen
 			// The LoadBooksCmd will fetch data asynchronously
 			return m, m.LoadBooksCmd(), models.ListBooksScreen
-		case "Ｕｔｉｌｉｔｉｅｓ":
+		case config.MenuUtilities:
 			// Navigate to utilities screen
 			return m, nil, models.UtilitiesScreen
-		case "Ｔｈｅｍｅ":
+		case config.MenuTheme:
 			// Navigate to theme selection screen
 			return m, nil, models.ThemeScreen
-		case "Ｑｕｉｔ":
+		case config.MenuQuit:
 			// Exit the application
 			return m, tea.Quit, models.MenuScreen
 		}
@@ -125,27 +185,78 @@ func (m MenuModel) View() string {
 
 	// Display application title with emoji and branding
 	b.WriteString("\n")
-	b.WriteString(styles.TitleStyle().Render("Ｌｉｂｒｏｓ　－　Ａ　Ｂｏｏｋ　Ｍａｎａｇｅｒ"))
+	b.WriteString(styles.TitleStyle().Render(styles.Label(i18n.T(i18n.AppTitle))))
 	b.WriteString("\n\n")
 
+	// Name the open library when it is not the default one
+	if library := filepath.Base(m.db.Path()); library != "books.db" {
+		b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing(fmt.Sprintf(i18n.T(i18n.MenuLibrary), library))))
+		b.WriteString("\n\n")
+	}
+
+	// Remind the user which book they are reading, when one is marked
+	if m.reading != nil {
+		b.WriteString(styles.FocusedStyle().Render(styles.AddLetterSpacing(fmt.Sprintf(i18n.T(i18n.MenuReading), m.reading.Title))))
+		b.WriteString("\n\n")
+	}
+	if m.wishlist > 0 {
+		b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing(fmt.Sprintf(i18n.T(i18n.MenuWishlist), m.wishlist))))
+		b.WriteString("\n\n")
+	}
+
 	// Render each menu item with appropriate styling
 	for i, item := range m.items {
+		label := styles.Label(i18n.T(menuLabels[item]))
 		if i == m.index {
 			// Highlight currently selected item
-			b.WriteString(styles.SelectedStyle().Render(item))
+			b.WriteString(styles.SelectedStyle().Render(label))
 		} else {
 			// Dim non-selected items
-			b.WriteString(styles.BlurredStyle.Render(item))
+			b.WriteString(styles.BlurredStyle.Render(label))
 		}
 		b.WriteString("\n\n")
 	}
 
+	// Show a non-fatal notice when the config file could not be parsed
+	if m.configErr != nil {
+		b.WriteString(styles.ErrorStyle.Render(styles.AddLetterSpacing(fmt.Sprintf(i18n.T(i18n.MenuConfigUnreadable), m.configErr))))
+		b.WriteString("\n")
+		b.WriteString(renderHelp(help.MenuConfigError))
+		b.WriteString("\n")
+	} else if m.configStatus != "" {
+		statusStyle := styles.SuccessStyle
+		if m.configResetErr {
+			statusStyle = styles.ErrorStyle
+		}
+		b.WriteString(statusStyle.Render(styles.AddLetterSpacing(m.configStatus)))
+		b.WriteString("\n")
+	}
+
+	// Summarize the collection in one line above the help text
+	if m.count > 0 {
+		b.WriteString("\n" + styles.HelpTextStyle.Render(styles.AddLetterSpacing(m.summary())))
+		b.WriteString("\n")
+	}
+
 	// Display help text for user guidance
-	b.WriteString("\n" + styles.HelpTextStyle.Render(styles.AddLetterSpacing("Use ↑/↓ or j/k to navigate, Enter to select, q or Ctrl+C to quit")))
+	b.WriteString("\n" + renderHelp(help.Menu))
 
 	return b.String()
 }
 
+// summary describes the collection in one line, e.g. "42 books · 1 reading"
+// Only the total is shown until a book is marked as currently reading
+func (m MenuModel) summary() string {
+	parts := []string{fmt.Sprintf(i18n.T(i18n.MenuBooks), m.count)}
+	if m.count == 1 {
+		parts[0] = i18n.T(i18n.MenuOneBook)
+	}
+	if m.reading != nil {
+		parts = append(parts, i18n.T(i18n.MenuOneReading))
+	}
+	return strings.Join(parts, " · ")
+}
+
 // RefreshItems updates the menu items based on the current database state.
 // This is typically called when returning to the menu from other screens
 // to ensure the "View Books" option appears/disappears based on book count.
@@ -0,0 +1,45 @@
+package screens
+
+import (
+	"slices"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/papadavis47/libros/internal/config"
+	"github.com/papadavis47/libros/internal/models"
+)
+
+// TestMenu_EmptyLibraryCanImport tests that a fresh library with no books can
+// still reach the import screens, so an encrypted backup can be restored into it
+func TestMenu_EmptyLibraryCanImport(t *testing.T) {
+	db := openTestDB(t)
+
+	menu := NewMenuModel(db)
+	if !slices.Contains(menu.items, config.MenuUtilities) {
+		t.Fatalf("Menu items = %v, want Utilities for an empty library", menu.items)
+	}
+	if slices.Contains(menu.items, config.MenuView) {
+		t.Errorf("Menu items = %v, want no View Books for an empty library", menu.items)
+	}
+
+	tests := []struct {
+		item   string
+		screen models.Screen
+	}{
+		{"Ｉｍｐｏｒｔ　Ｔｉｔｌｅｓ", models.ImportScreen},
+		{"Ｂｕｌｋ　Ａｄｄ", models.BulkAddScreen},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.item, func(t *testing.T) {
+			utilities := NewUtilitiesModel(db)
+			utilities.index = slices.Index(utilities.items, tt.item)
+			if utilities.index < 0 {
+				t.Fatalf("Utilities has no %q entry", tt.item)
+			}
+			if _, _, screen := utilities.Update(tea.KeyMsg{Type: tea.KeyEnter}); screen != tt.screen {
+				t.Errorf("Choosing %q went to screen %v, want %v", tt.item, screen, tt.screen)
+			}
+		})
+	}
+}
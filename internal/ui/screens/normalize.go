@@ -0,0 +1,258 @@
+package screens
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/papadavis47/libros/internal/constants"
+	"github.com/papadavis47/libros/internal/database"
+	"github.com/papadavis47/libros/internal/factory"
+	"github.com/papadavis47/libros/internal/help"
+	"github.com/papadavis47/libros/internal/messages"
+	"github.com/papadavis47/libros/internal/models"
+	"github.com/papadavis47/libros/internal/styles"
+	"github.com/papadavis47/libros/internal/utils"
+)
+
+// Placeholders saved for a title or author left blank on the Fix Empty Fields screen
+const (
+	untitledPlaceholder      = "Untitled"
+	unknownAuthorPlaceholder = "Unknown Author"
+)
+
+// NormalizeScreen walks through the books whose title or author is empty,
+// one at a time, so each can be given a real value or a placeholder.
+// Nothing is changed until the user saves a book, and any book can be skipped.
+type NormalizeScreen struct {
+	db      *database.DB
+	loading bool
+	saving  bool
+	done    bool
+	books   []models.Book
+	index   int
+	inputs  []textinput.Model // [0]=title, [1]=author
+	focused int
+	fixed   int
+	skipped int
+	status  string
+	err     error
+}
+
+func NewNormalizeScreen(db *database.DB) *NormalizeScreen {
+	return &NormalizeScreen{
+		db: db,
+		inputs: []textinput.Model{
+			factory.CreateTextInput(untitledPlaceholder, constants.TitleMaxLength),
+			factory.CreateTextInput(unknownAuthorPlaceholder, constants.AuthorMaxLength),
+		},
+	}
+}
+
+// Start clears any previous result and looks for incomplete books.
+// It returns the command that loads them.
+func (s *NormalizeScreen) Start() tea.Cmd {
+	s.loading = true
+	s.saving = false
+	s.done = false
+	s.books = nil
+	s.index = 0
+	s.fixed = 0
+	s.skipped = 0
+	s.status = ""
+	s.err = nil
+	return s.loadIncompleteBooksCmd()
+}
+
+func (s *NormalizeScreen) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+func (s *NormalizeScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		// Ignore input while the books are loaded or a fix is saved
+		if s.loading || s.saving {
+			return s, nil
+		}
+		if s.done || s.err != nil || len(s.books) == 0 {
+			switch msg.String() {
+			case "enter", "esc":
+				return s, SwitchScreenCmd(models.UtilitiesScreen)
+			}
+			return s, nil
+		}
+		switch msg.String() {
+		case "tab", "shift+tab", "up", "down":
+			s.focus(1 - s.focused)
+			return s, nil
+		case "enter":
+			// Blank fields are saved with their placeholder
+			book := s.books[s.index]
+			title := valueOr(s.inputs[0].Value(), untitledPlaceholder)
+			author := valueOr(s.inputs[1].Value(), unknownAuthorPlaceholder)
+			s.saving = true
+			s.status = ""
+			return s, s.fixBookCmd(book, title, author)
+		case "ctrl+n":
+			// Leave this book as it is
+			s.skipped++
+			return s, s.next()
+		case "esc":
+			// Stop early; the remaining books are left as they are
+			s.done = true
+			return s, nil
+		}
+
+	case messages.IncompleteBooksMsg:
+		s.loading = false
+		s.books = msg.Books
+		s.err = msg.Err
+		if len(s.books) > 0 {
+			return s, s.showBook()
+		}
+		return s, nil
+
+	case messages.FixBookMsg:
+		s.saving = false
+		if msg.Err != nil {
+			s.status = "Could not save: " + msg.Err.Error()
+			return s, nil
+		}
+		s.fixed++
+		return s, s.next()
+	}
+
+	var cmd tea.Cmd
+	if !s.loading && !s.done && len(s.books) > 0 {
+		s.inputs[s.focused], cmd = s.inputs[s.focused].Update(msg)
+	}
+	return s, cmd
+}
+
+// next moves on to the following book, or finishes after the last one
+func (s *NormalizeScreen) next() tea.Cmd {
+	s.index++
+	if s.index >= len(s.books) {
+		s.done = true
+		return nil
+	}
+	return s.showBook()
+}
+
+// showBook fills the inputs with the current book's title and author,
+// trimmed so a whitespace-only value shows as blank, and focuses whichever is missing
+func (s *NormalizeScreen) showBook() tea.Cmd {
+	book := s.books[s.index]
+	s.inputs[0].SetValue(strings.TrimSpace(book.Title))
+	s.inputs[1].SetValue(strings.TrimSpace(book.Author))
+	if s.inputs[0].Value() == "" {
+		return s.focus(0)
+	}
+	return s.focus(1)
+}
+
+// focus moves the cursor to the given input
+func (s *NormalizeScreen) focus(index int) tea.Cmd {
+	s.focused = index
+	for i := range s.inputs {
+		s.inputs[i].Blur()
+	}
+	return s.inputs[index].Focus()
+}
+
+func (s *NormalizeScreen) View() string {
+	var b strings.Builder
+
+	b.WriteString("\n")
+	b.WriteString(styles.TitleStyle().Render(styles.Label("Ｆｉｘ　Ｅｍｐｔｙ　Ｆｉｅｌｄｓ")))
+	b.WriteString("\n\n")
+
+	statusStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#00FF00")).
+		Bold(true).
+		Padding(1, 0).
+		PaddingLeft(3)
+
+	switch {
+	case s.loading:
+		b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing("Looking for books with an empty title or author...")))
+		b.WriteString("\n")
+
+	case s.err != nil:
+		b.WriteString(styles.ErrorStyle.Render(styles.AddLetterSpacing("Error: " + s.err.Error())))
+		b.WriteString("\n")
+		b.WriteString("\n" + renderHelp(help.NormalizeDone))
+
+	case s.done:
+		b.WriteString(statusStyle.Render(styles.AddLetterSpacing(fmt.Sprintf("Fixed %d book(s), skipped %d", s.fixed, s.skipped))))
+		b.WriteString("\n")
+		if left := len(s.books) - s.fixed - s.skipped; left > 0 {
+			b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing(fmt.Sprintf("%d book(s) were not reviewed", left))))
+			b.WriteString("\n")
+		}
+		b.WriteString("\n" + renderHelp(help.NormalizeDone))
+
+	case len(s.books) == 0:
+		b.WriteString(statusStyle.Render(styles.AddLetterSpacing("Every book has a title and author. Nothing to fix.")))
+		b.WriteString("\n")
+		b.WriteString("\n" + renderHelp(help.NormalizeDone))
+
+	default:
+		book := s.books[s.index]
+		b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing(fmt.Sprintf("Book %d of %d (ID %d, added %s)", s.index+1, len(s.books), book.ID, utils.FormatDate(book.CreatedAt)))))
+		b.WriteString("\n\n")
+		if book.Notes != "" {
+			b.WriteString(styles.SpacedNotesStyle.Render("\"" + styles.AddLetterSpacing(truncateNotes(book.Notes, constants.TextWrapWidth)) + "\""))
+			b.WriteString("\n\n")
+		}
+		for i, label := range []string{"Title:", "Author:"} {
+			if i == s.focused {
+				b.WriteString(styles.FocusedStyle().Render(styles.AddLetterSpacing(label)))
+			} else {
+				b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing(label)))
+			}
+			b.WriteString("\n")
+			b.WriteString(s.inputs[i].View())
+			b.WriteString("\n\n")
+		}
+		if s.saving {
+			b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing("Saving...")))
+			b.WriteString("\n")
+		} else if s.status != "" {
+			b.WriteString(styles.ErrorStyle.Render(styles.AddLetterSpacing(s.status)))
+			b.WriteString("\n")
+		}
+		b.WriteString("\n" + renderHelp(help.Normalize))
+	}
+
+	return b.String()
+}
+
+// loadIncompleteBooksCmd finds the books with an empty title or author asynchronously,
+// reporting them as an IncompleteBooksMsg.
+func (s *NormalizeScreen) loadIncompleteBooksCmd() tea.Cmd {
+	return func() tea.Msg {
+		books, err := s.db.LoadIncompleteBooks()
+		return messages.IncompleteBooksMsg{Books: books, Err: err}
+	}
+}
+
+// fixBookCmd saves the new title and author asynchronously, keeping the book's
+// other fields, and reports the result as a FixBookMsg.
+func (s *NormalizeScreen) fixBookCmd(book models.Book, title, author string) tea.Cmd {
+	return func() tea.Msg {
+		err := s.db.UpdateBook(book.ID, title, author, book.Type, book.Notes, book.Location)
+		return messages.FixBookMsg{Err: err}
+	}
+}
+
+// valueOr returns the trimmed value, or fallback when it is blank
+func valueOr(value, fallback string) string {
+	if value = strings.TrimSpace(value); value == "" {
+		return fallback
+	}
+	return value
+}
@@ -0,0 +1,201 @@
+package screens
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/papadavis47/libros/internal/constants"
+	"github.com/papadavis47/libros/internal/database"
+	"github.com/papadavis47/libros/internal/factory"
+	"github.com/papadavis47/libros/internal/help"
+	"github.com/papadavis47/libros/internal/messages"
+	"github.com/papadavis47/libros/internal/models"
+	"github.com/papadavis47/libros/internal/styles"
+)
+
+// PaletteModel is the quick switcher opened with ctrl+p. Typing narrows the
+// whole collection by fuzzy matching on title and author, and Enter picks the
+// highlighted book. The books are loaded when the palette opens and kept
+// until it closes, so typing does not query the database.
+type PaletteModel struct {
+	db      *database.DB
+	input   textinput.Model
+	active  bool
+	loading bool
+	books   []models.Book
+	matches []int // Indexes into books, best match first
+	index   int   // Highlighted position in matches
+	err     error
+}
+
+// NewPaletteModel creates a closed quick switcher
+func NewPaletteModel(db *database.DB) PaletteModel {
+	return PaletteModel{
+		db:    db,
+		input: factory.CreateTextInput("Title or author", constants.TitleMaxLength),
+	}
+}
+
+// Open shows the palette with an empty query and returns the commands
+// that focus the input and load the books
+func (m *PaletteModel) Open() tea.Cmd {
+	m.active = true
+	m.loading = true
+	m.books = nil
+	m.matches = nil
+	m.index = 0
+	m.err = nil
+	m.input.Reset()
+	return tea.Batch(m.input.Focus(), m.loadBooksCmd())
+}
+
+// Close hides the palette and drops the cached books
+func (m *PaletteModel) Close() {
+	m.active = false
+	m.loading = false
+	m.books = nil
+	m.matches = nil
+	m.input.Blur()
+}
+
+// Active reports whether the palette is open
+func (m PaletteModel) Active() bool {
+	return m.active
+}
+
+// Books returns the collection loaded when the palette was opened
+func (m PaletteModel) Books() []models.Book {
+	return m.books
+}
+
+// Update handles typing, navigation and selection while the palette is open.
+// It returns the ID of the chosen book, or 0 if none was chosen; choosing a
+// book leaves the palette open so the caller can read Books before closing it.
+func (m PaletteModel) Update(msg tea.Msg) (PaletteModel, tea.Cmd, int) {
+	switch msg := msg.(type) {
+	case messages.PaletteBooksMsg:
+		// A load finishing after the palette was closed is stale
+		if !m.active {
+			return m, nil, 0
+		}
+		m.loading = false
+		m.books = msg.Books
+		m.err = msg.Err
+		m.filter()
+		return m, nil, 0
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "esc":
+			m.Close()
+			return m, nil, 0
+		case "enter":
+			if m.index < len(m.matches) {
+				return m, nil, m.books[m.matches[m.index]].ID
+			}
+			return m, nil, 0
+		case "up", "ctrl+k":
+			if m.index > 0 {
+				m.index--
+			}
+			return m, nil, 0
+		case "down", "ctrl+j":
+			if m.index < len(m.matches)-1 {
+				m.index++
+			}
+			return m, nil, 0
+		}
+	}
+
+	var cmd tea.Cmd
+	query := m.input.Value()
+	m.input, cmd = m.input.Update(msg)
+	if m.input.Value() != query {
+		m.filter()
+	}
+	return m, cmd, 0
+}
+
+// filter matches the query against every book's title and author,
+// best match first. An empty query lists every book.
+func (m *PaletteModel) filter() {
+	m.index = 0
+	m.matches = m.matches[:0]
+	query := strings.TrimSpace(m.input.Value())
+	if query == "" {
+		for i := range m.books {
+			m.matches = append(m.matches, i)
+		}
+		return
+	}
+	targets := make([]string, len(m.books))
+	for i, book := range m.books {
+		targets[i] = book.Title + " " + book.Author
+	}
+	for _, rank := range list.DefaultFilter(query, targets) {
+		m.matches = append(m.matches, rank.Index)
+	}
+}
+
+// View renders the query and the best matches, keeping the highlighted
+// match in view
+func (m PaletteModel) View() string {
+	var b strings.Builder
+
+	b.WriteString("\n")
+	b.WriteString(styles.TitleStyle().Render(styles.Label("Ｊｕｍｐ　ｔｏ　Ｂｏｏｋ")))
+	b.WriteString("\n\n")
+	b.WriteString(m.input.View())
+	b.WriteString("\n\n")
+
+	switch {
+	case m.loading:
+		b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing("Loading books...")))
+		b.WriteString("\n")
+	case m.err != nil:
+		b.WriteString(styles.ErrorStyle.Render(styles.AddLetterSpacing("Error: " + m.err.Error())))
+		b.WriteString("\n")
+	case len(m.books) == 0:
+		b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing("No books in your collection yet.")))
+		b.WriteString("\n")
+	case len(m.matches) == 0:
+		b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing("No matching books.")))
+		b.WriteString("\n")
+	default:
+		start := 0
+		if m.index >= constants.PaletteMaxResults {
+			start = m.index - constants.PaletteMaxResults + 1
+		}
+		end := min(start+constants.PaletteMaxResults, len(m.matches))
+		for i := start; i < end; i++ {
+			book := m.books[m.matches[i]]
+			item := book.Title + " by " + book.Author
+			if i == m.index {
+				b.WriteString(styles.SelectedStyle().Render(styles.AddLetterSpacing(item)))
+			} else {
+				b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing(item)))
+			}
+			b.WriteString("\n")
+		}
+		if len(m.matches) > end-start {
+			b.WriteString("\n" + styles.BlurredStyle.Render(styles.AddLetterSpacing(fmt.Sprintf("%d of %d matches", end-start, len(m.matches)))))
+			b.WriteString("\n")
+		}
+	}
+
+	b.WriteString("\n" + renderHelp(help.Palette))
+
+	return b.String()
+}
+
+// loadBooksCmd loads the whole collection asynchronously,
+// reporting it as a PaletteBooksMsg.
+func (m PaletteModel) loadBooksCmd() tea.Cmd {
+	return func() tea.Msg {
+		books, err := m.db.LoadBooks()
+		return messages.PaletteBooksMsg{Books: books, Err: err}
+	}
+}
@@ -0,0 +1,12 @@
+package screens
+
+// Pinned is implemented by screens whose content can grow taller than the terminal.
+// When pin_header is on, the root model keeps the header in place at the top
+// and scrolls the body beneath it; otherwise the two are simply joined.
+type Pinned interface {
+	// Header renders the title lines kept at the top of the screen
+	Header() string
+
+	// Body renders everything below the header
+	Body() string
+}
@@ -0,0 +1,153 @@
+package screens
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/papadavis47/libros/internal/constants"
+	"github.com/papadavis47/libros/internal/database"
+	"github.com/papadavis47/libros/internal/help"
+	"github.com/papadavis47/libros/internal/messages"
+	"github.com/papadavis47/libros/internal/models"
+	"github.com/papadavis47/libros/internal/styles"
+	"github.com/papadavis47/libros/internal/utils"
+)
+
+// StatsScreen shows a summary of the collection, such as how many books
+// have notes and how long those notes tend to be, and a chart of the books
+// added each year.
+type StatsScreen struct {
+	db          *database.DB
+	loading     bool
+	stats       models.Stats
+	addedByYear map[int]int // Books added in each year that had additions
+	hideEmpty   bool        // Whether years with no additions are left out of the chart
+	err         error
+}
+
+func NewStatsScreen(db *database.DB) *StatsScreen {
+	return &StatsScreen{db: db}
+}
+
+// Start clears any previous result and loads fresh statistics.
+// It returns the command that gathers them.
+func (s *StatsScreen) Start() tea.Cmd {
+	s.loading = true
+	s.stats = models.Stats{}
+	s.addedByYear = nil
+	s.err = nil
+	return s.loadStatsCmd()
+}
+
+func (s *StatsScreen) Init() tea.Cmd {
+	return nil
+}
+
+func (s *StatsScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "esc", "enter":
+			// Return to utilities screen
+			return s, SwitchScreenCmd(models.UtilitiesScreen)
+		case "q", "ctrl+c":
+			return s, tea.Quit
+		case "y":
+			// Show or hide the years with no additions between the first and last
+			s.hideEmpty = !s.hideEmpty
+		}
+
+	case messages.StatsMsg:
+		s.loading = false
+		s.stats = msg.Stats
+		s.addedByYear = msg.AddedByYear
+		s.err = msg.Err
+	}
+
+	return s, nil
+}
+
+func (s *StatsScreen) View() string {
+	return s.Header() + s.Body()
+}
+
+// Header renders the screen title, which stays in place when the header is pinned
+func (s *StatsScreen) Header() string {
+	return "\n" + styles.TitleStyle().Render(styles.Label("Ｓｔａｔｉｓｔｉｃｓ")) + "\n\n"
+}
+
+// Body renders the statistics and the chart, which scroll on short terminals
+// when the header is pinned
+func (s *StatsScreen) Body() string {
+	var b strings.Builder
+
+	switch {
+	case s.loading:
+		b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing("Gathering statistics...")))
+		b.WriteString("\n")
+	case s.err != nil:
+		b.WriteString(styles.ErrorStyle.Render(styles.AddLetterSpacing("Error: " + s.err.Error())))
+		b.WriteString("\n")
+	default:
+		s.writeStat(&b, "Total books:", fmt.Sprintf("%d", s.stats.TotalBooks))
+		s.writeStat(&b, "Books with notes:", fmt.Sprintf("%d / %d", s.stats.BooksWithNotes, s.stats.TotalBooks))
+		s.writeStat(&b, "Total note words:", fmt.Sprintf("%d", s.stats.NoteWords))
+		s.writeStat(&b, "Avg note length:", fmt.Sprintf("%d words", s.stats.AverageNoteWords()))
+		s.writeYearChart(&b)
+	}
+
+	b.WriteString("\n" + renderHelp(help.Stats))
+
+	return b.String()
+}
+
+// writeStat renders one labelled statistic on its own line
+func (s *StatsScreen) writeStat(b *strings.Builder, label, value string) {
+	b.WriteString(styles.SpacedBlurredStyle.Render(styles.AddLetterSpacing(label)))
+	b.WriteString(styles.BookAuthorUnselectedStyle().Render(styles.AddLetterSpacing(value)))
+	b.WriteString("\n\n")
+}
+
+// writeYearChart renders a bar for each year from the first book added to the last.
+// Years in between with no additions show an empty bar unless they are hidden.
+func (s *StatsScreen) writeYearChart(b *strings.Builder) {
+	b.WriteString(styles.FocusedStyle().Render(styles.AddLetterSpacing("Books added per year:")))
+	b.WriteString("\n\n")
+	if len(s.addedByYear) == 0 {
+		b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing("No books added yet.")))
+		b.WriteString("\n")
+		return
+	}
+
+	first, last, most := 0, 0, 0
+	for year, count := range s.addedByYear {
+		if first == 0 || year < first {
+			first = year
+		}
+		last = max(last, year)
+		most = max(most, count)
+	}
+	for year := first; year <= last; year++ {
+		count := s.addedByYear[year]
+		if count == 0 && s.hideEmpty {
+			continue
+		}
+		bar := fmt.Sprintf("%-*s", constants.StatsBarWidth, utils.FormatBar(count, most, constants.StatsBarWidth))
+		b.WriteString(styles.SpacedBlurredStyle.Render(styles.AddLetterSpacing(fmt.Sprintf("%d", year))))
+		b.WriteString(styles.BookAuthorUnselectedStyle().Render(bar + "  " + styles.AddLetterSpacing(fmt.Sprintf("%d", count))))
+		b.WriteString("\n")
+	}
+}
+
+// loadStatsCmd gathers the statistics asynchronously and reports them as a StatsMsg.
+func (s *StatsScreen) loadStatsCmd() tea.Cmd {
+	return func() tea.Msg {
+		stats, err := s.db.GetStatistics()
+		if err != nil {
+			return messages.StatsMsg{Err: err}
+		}
+		addedByYear, err := s.db.GetBooksAddedByYear()
+		return messages.StatsMsg{Stats: stats, AddedByYear: addedByYear, Err: err}
+	}
+}
@@ -6,6 +6,8 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/papadavis47/libros/internal/config"
+	"github.com/papadavis47/libros/internal/help"
+	"github.com/papadavis47/libros/internal/i18n"
 	"github.com/papadavis47/libros/internal/models"
 	"github.com/papadavis47/libros/internal/styles"
 )
@@ -14,6 +16,7 @@ import (
 type ThemeModel struct {
 	options []ThemeOption
 	index   int
+	err     error // Error from the last attempt to save a theme, if any
 }
 
 // ThemeOption represents a theme option for display
@@ -21,6 +24,7 @@ type ThemeOption struct {
 	Name        string
 	Value       string
 	Color       string
+	TextColor   string
 	DisplayName string
 }
 
@@ -41,6 +45,7 @@ func NewThemeModel() ThemeModel {
 			Name:        option.Name,
 			Value:       option.Value,
 			Color:       option.Color,
+			TextColor:   option.TextColor,
 			DisplayName: styles.AddLetterSpacing(option.Name), // Just the spaced name
 		})
 	}
@@ -70,6 +75,9 @@ func (m ThemeModel) Init() tea.Cmd {
 func (m ThemeModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		// Any key press dismisses a previous save error
+		m.err = nil
+
 		switch msg.String() {
 		case "esc":
 			// Return to main menu without saving
@@ -93,10 +101,9 @@ func (m ThemeModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			
 			// Save the selected theme
 			if err := config.UpdateTheme(selectedTheme); err != nil {
-				// Handle error - for now just return to menu
-				return m, func() tea.Msg {
-					return SwitchScreenMsg{Screen: models.MenuScreen}
-				}
+				// Stay on the theme screen and report that the theme did not persist
+				m.err = err
+				return m, nil
 			}
 			
 			// Send theme selected message and return to menu
@@ -120,7 +127,7 @@ func (m ThemeModel) View() string {
 
 	// Display application title and screen subtitle
 	b.WriteString("\n")
-	b.WriteString(styles.TitleStyle().Render("Ｌｉｂｒｏｓ　－　Ａ　Ｂｏｏｋ　Ｍａｎａｇｅｒ"))
+	b.WriteString(styles.TitleStyle().Render(styles.Label(i18n.T(i18n.AppTitle))))
 	b.WriteString("\n\n")
 	b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing("Pick Theme")))
 	b.WriteString("\n\n")
@@ -130,10 +137,14 @@ func (m ThemeModel) View() string {
 	// Render each theme option with dynamic background colors
 	for i, option := range m.options {
 		if i == m.index {
-			// Selected item: use the theme's color as background with white text
+			// Selected item: use the theme's color as background with its selected text color
+			textColor := option.TextColor
+			if textColor == "" {
+				textColor = "#FFFFFF"
+			}
 			selectedStyle := lipgloss.NewStyle().
 				Bold(true).
-				Foreground(lipgloss.Color("#FFFFFF")).
+				Foreground(lipgloss.Color(textColor)).
 				Background(lipgloss.Color(option.Color)).
 				Padding(0, 1).
 				MarginLeft(2).
@@ -151,8 +162,14 @@ func (m ThemeModel) View() string {
 		b.WriteString("\n\n")
 	}
 
+	// Show why the last theme selection could not be saved
+	if m.err != nil {
+		b.WriteString(styles.ErrorStyle.Render(styles.AddLetterSpacing("Failed to save theme: " + m.err.Error())))
+		b.WriteString("\n")
+	}
+
 	// Display help text for user guidance
-	b.WriteString("\n" + styles.HelpTextStyle.Render(styles.AddLetterSpacing("Use ↑/↓ or j/k to navigate, Enter to select, Esc to return to menu")))
+	b.WriteString("\n" + renderHelp(help.Theme))
 
 	return b.String()
 }
\ No newline at end of file
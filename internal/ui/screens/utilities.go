@@ -2,15 +2,19 @@ package screens
 
 import (
 	"strings"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/papadavis47/libros/internal/database"
+	"github.com/papadavis47/libros/internal/help"
+	"github.com/papadavis47/libros/internal/messages"
 	"github.com/papadavis47/libros/internal/models"
 	"github.com/papadavis47/libros/internal/styles"
+	"github.com/papadavis47/libros/internal/utils"
 )
 
-// UtilitiesModel represents the utilities menu screen that provides options for
-// Export and Backup functionality.
+// UtilitiesModel represents the utilities menu screen, the entry point for tools that
+// work on the collection as a whole rather than on a single book.
 type UtilitiesModel struct {
 	db    *database.DB // Database connection for utilities operations
 	items []string     // Menu items to display
@@ -27,8 +31,24 @@ type UtilitiesModel struct {
 //   - UtilitiesModel: Fully initialized utilities model ready for use
 func NewUtilitiesModel(db *database.DB) UtilitiesModel {
 	items := []string{
+		"Ａｄｄｅｄ　Ｔｈｉｓ　Ｗｅｅｋ",
+		"Ａｄｄｅｄ　Ｔｈｉｓ　Ｍｏｎｔｈ",
+		"Ｗｉｓｈｌｉｓｔ",
+		"Ｌｅｎｔ　Ｏｕｔ",
+		"Ｓｔａｔｉｓｔｉｃｓ",
+		"Ｉｍｐｏｒｔ　Ｔｉｔｌｅｓ",
+		"Ｂｕｌｋ　Ａｄｄ",
 		"Ｅｘｐｏｒｔ",
+		"Ｖｅｒｉｆｙ　Ｅｘｐｏｒｔ",
 		"Ｂａｃｋｕｐ",
+		"Ｄａｔａｂａｓｅ　Ｉｎｆｏ",
+		"Ｓｗｉｔｃｈ　Ｌｉｂｒａｒｙ",
+		"Ｃｈｅｃｋ　Ｉｎｔｅｇｒｉｔｙ",
+		"Ｖａｌｉｄａｔｅ　Ｃｏｌｌｅｃｔｉｏｎ",
+		"Ｆｉｘ　Ｅｍｐｔｙ　Ｆｉｅｌｄｓ",
+		"Ｎｅｅｄｓ　Ａｔｔｅｎｔｉｏｎ",
+		"Ｆｉｎｄ　Ｄｕｐｌｉｃａｔｅｓ",
+		"Ｃｌｅａｒ　Ｃｏｌｌｅｃｔｉｏｎ",
 		"Ｂａｃｋ　ｔｏ　Ｍａｉｎ　Ｍｅｎｕ",
 	}
 
@@ -63,12 +83,60 @@ func (u UtilitiesModel) Update(msg tea.KeyMsg) (UtilitiesModel, tea.Cmd, models.
 	case "enter": // Activate selected menu item
 		selectedItem := u.items[u.index]
 		switch selectedItem {
+		case "Ａｄｄｅｄ　Ｔｈｉｓ　Ｗｅｅｋ":
+			// Show books added since Monday in the book list
+			return u, u.loadAddedSinceCmd(utils.StartOfWeek(time.Now()), selectedItem), models.ListBooksScreen
+		case "Ａｄｄｅｄ　Ｔｈｉｓ　Ｍｏｎｔｈ":
+			// Show books added since the first of the month in the book list
+			return u, u.loadAddedSinceCmd(utils.StartOfMonth(time.Now()), selectedItem), models.ListBooksScreen
+		case "Ｗｉｓｈｌｉｓｔ":
+			// Show only the books not yet owned in the book list
+			return u, u.loadWishlistCmd(selectedItem), models.ListBooksScreen
+		case "Ｌｅｎｔ　Ｏｕｔ":
+			// Show only the books currently lent out, with who has them and since when
+			return u, u.loadLentCmd(selectedItem), models.ListBooksScreen
+		case "Ｓｔａｔｉｓｔｉｃｓ":
+			// Navigate to the collection statistics
+			return u, nil, models.StatsScreen
+		case "Ｉｍｐｏｒｔ　Ｔｉｔｌｅｓ":
+			// Navigate to the import of a "Title - Author" text file
+			return u, nil, models.ImportScreen
+		case "Ｂｕｌｋ　Ａｄｄ":
+			// Navigate to the form for pasting several "Title | Author | Type" lines
+			return u, nil, models.BulkAddScreen
 		case "Ｅｘｐｏｒｔ":
 			// Navigate to export screen for export functionality
 			return u, nil, models.ExportScreen
+		case "Ｖｅｒｉｆｙ　Ｅｘｐｏｒｔ":
+			// Navigate to the check of a JSON export's book count
+			return u, nil, models.VerifyExportScreen
 		case "Ｂａｃｋｕｐ":
 			// Navigate to database backup functionality
 			return u, nil, models.BackupScreen
+		case "Ｄａｔａｂａｓｅ　Ｉｎｆｏ":
+			// Navigate to the read-only database file details
+			return u, nil, models.DBInfoScreen
+		case "Ｓｗｉｔｃｈ　Ｌｉｂｒａｒｙ":
+			// Navigate to the list of library databases to switch between
+			return u, nil, models.LibraryScreen
+		case "Ｃｈｅｃｋ　Ｉｎｔｅｇｒｉｔｙ":
+			// Navigate to database integrity check
+			return u, nil, models.IntegrityScreen
+		case "Ｖａｌｉｄａｔｅ　Ｃｏｌｌｅｃｔｉｏｎ":
+			// Navigate to the read-only check of every stored book
+			return u, nil, models.ValidateScreen
+		case "Ｆｉｘ　Ｅｍｐｔｙ　Ｆｉｅｌｄｓ":
+			// Navigate to the guided fix for books with an empty title or author
+			return u, nil, models.NormalizeScreen
+		case "Ｎｅｅｄｓ　Ａｔｔｅｎｔｉｏｎ":
+			// Navigate to the read-only report of books missing information
+			return u, nil, models.AttentionScreen
+		case "Ｆｉｎｄ　Ｄｕｐｌｉｃａｔｅｓ":
+			// Navigate to the duplicate finder, which merges groups after confirmation
+			return u, nil, models.DuplicatesScreen
+		case "Ｃｌｅａｒ　Ｃｏｌｌｅｃｔｉｏｎ":
+			// Navigate to the confirmation screen for deleting every book
+			return u, nil, models.ClearScreen
 		case "Ｂａｃｋ　ｔｏ　Ｍａｉｎ　Ｍｅｎｕ":
 			// Return to main menu
 			return u, nil, models.MenuScreen
@@ -89,23 +157,91 @@ func (u UtilitiesModel) View() string {
 
 	// Display utilities title
 	b.WriteString("\n")
-	b.WriteString(styles.TitleStyle().Render("Ｕｔｉｌｉｔｉｅｓ"))
+	b.WriteString(styles.TitleStyle().Render(styles.Label("Ｕｔｉｌｉｔｉｅｓ")))
 	b.WriteString("\n\n")
 
 	// Render each menu item with appropriate styling
 	for i, item := range u.items {
 		if i == u.index {
 			// Highlight currently selected item
-			b.WriteString(styles.SelectedStyle().Render(item))
+			b.WriteString(styles.SelectedStyle().Render(styles.Label(item)))
 		} else {
 			// Dim non-selected items
-			b.WriteString(styles.BlurredStyle.Render(item))
+			b.WriteString(styles.BlurredStyle.Render(styles.Label(item)))
 		}
 		b.WriteString("\n\n")
 	}
 
 	// Display help text for user guidance
-	b.WriteString("\n" + styles.HelpTextStyle.Render(styles.AddLetterSpacing("Use ↑/↓ or j/k to navigate, Enter to select, q or Ctrl+C to quit")))
+	b.WriteString("\n" + renderHelp(help.Utilities))
 
 	return b.String()
-}
\ No newline at end of file
+}
+
+// loadAddedSinceCmd creates a command that loads the books added since start,
+// labelled with heading so the book list shows them as a subset of the collection.
+//
+// Parameters:
+//   - start: Beginning of the period; books added at or after it are included
+//   - heading: Subtitle for the book list
+//
+// Returns:
+//   - tea.Cmd: Command that loads the books and returns LoadBooksMsg
+func (u UtilitiesModel) loadAddedSinceCmd(start time.Time, heading string) tea.Cmd {
+	return func() tea.Msg {
+		// The period keeps its start, so a reload after a change shows the same books
+		load := func(db *database.DB) ([]models.Book, error) {
+			return db.LoadBooksBetween(start, time.Time{})
+		}
+		books, err := load(u.db)
+		return messages.LoadBooksMsg{
+			Books:     books,
+			Heading:   heading,
+			EmptyText: "No books added in this period.",
+			Load:      load,
+			Err:       err,
+		}
+	}
+}
+
+// loadWishlistCmd creates a command that loads the books not yet owned,
+// labelled with heading so the book list shows them as a subset of the collection.
+//
+// Parameters:
+//   - heading: Subtitle for the book list
+//
+// Returns:
+//   - tea.Cmd: Command that loads the wishlist and returns LoadBooksMsg
+func (u UtilitiesModel) loadWishlistCmd(heading string) tea.Cmd {
+	return func() tea.Msg {
+		books, err := u.db.LoadWishlist()
+		return messages.LoadBooksMsg{
+			Books:     books,
+			Heading:   heading,
+			EmptyText: "Your wishlist is empty. Choose Move to Wishlist on a book's details to add it.",
+			Load:      (*database.DB).LoadWishlist,
+			Err:       err,
+		}
+	}
+}
+
+// loadLentCmd creates a command that loads the books currently lent out,
+// labelled with heading so the book list shows them as a subset of the collection.
+//
+// Parameters:
+//   - heading: Subtitle for the book list
+//
+// Returns:
+//   - tea.Cmd: Command that loads the lent books and returns LoadBooksMsg
+func (u UtilitiesModel) loadLentCmd(heading string) tea.Cmd {
+	return func() tea.Msg {
+		books, err := u.db.LoadLentBooks()
+		return messages.LoadBooksMsg{
+			Books:     books,
+			Heading:   heading,
+			EmptyText: "No books are lent out. Choose Lend Book on a book's details to lend it.",
+			Load:      (*database.DB).LoadLentBooks,
+			Err:       err,
+		}
+	}
+}
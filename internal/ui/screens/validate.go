@@ -0,0 +1,145 @@
+package screens
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/papadavis47/libros/internal/config"
+	"github.com/papadavis47/libros/internal/database"
+	"github.com/papadavis47/libros/internal/help"
+	"github.com/papadavis47/libros/internal/messages"
+	"github.com/papadavis47/libros/internal/models"
+	"github.com/papadavis47/libros/internal/styles"
+	"github.com/papadavis47/libros/internal/validation"
+)
+
+// ValidateScreen checks every stored book against the same rules used when
+// books are added or edited, and lists any records that fail. It only reads
+// the collection and never changes it.
+type ValidateScreen struct {
+	db      *database.DB
+	spinner spinner.Model
+	running bool
+	checked int
+	issues  []validation.RecordIssue
+	err     error
+}
+
+func NewValidateScreen(db *database.DB) *ValidateScreen {
+	return &ValidateScreen{
+		db:      db,
+		spinner: spinner.New(spinner.WithSpinner(spinner.Dot)),
+	}
+}
+
+// Start resets any previous result and begins validating the collection.
+// It returns the commands that drive the spinner and run the validation.
+func (s *ValidateScreen) Start() tea.Cmd {
+	s.running = true
+	s.checked = 0
+	s.issues = nil
+	s.err = nil
+	s.spinner.Style = styles.FocusedStyle()
+	return tea.Batch(s.spinner.Tick, s.validateCollectionCmd())
+}
+
+func (s *ValidateScreen) Init() tea.Cmd {
+	return nil
+}
+
+func (s *ValidateScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "esc", "enter":
+			// Ignore enter until the validation has finished
+			if s.running && msg.String() == "enter" {
+				return s, nil
+			}
+			// Return to utilities screen
+			return s, SwitchScreenCmd(models.UtilitiesScreen)
+		case "q", "ctrl+c":
+			return s, tea.Quit
+		}
+
+	case spinner.TickMsg:
+		// Keep the spinner animating only while the validation is running
+		if !s.running {
+			return s, nil
+		}
+		var cmd tea.Cmd
+		s.spinner, cmd = s.spinner.Update(msg)
+		return s, cmd
+
+	case messages.ValidateCollectionMsg:
+		s.running = false
+		s.checked = msg.Checked
+		s.issues = msg.Issues
+		s.err = msg.Err
+	}
+
+	return s, nil
+}
+
+func (s *ValidateScreen) View() string {
+	var b strings.Builder
+
+	b.WriteString("\n")
+	b.WriteString(styles.TitleStyle().Render(styles.Label("Ｖａｌｉｄａｔｅ　Ｃｏｌｌｅｃｔｉｏｎ")))
+	b.WriteString("\n\n")
+
+	if s.running {
+		b.WriteString("   " + s.spinner.View() + " " + styles.BlurredNoPaddingStyle.Render(styles.AddLetterSpacing("Validating books...")))
+		b.WriteString("\n\n")
+		b.WriteString("\n" + renderHelp(help.ValidateRunning))
+		return b.String()
+	}
+
+	statusStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#00FF00")).
+		Bold(true).
+		Padding(1, 0).
+		PaddingLeft(3)
+
+	switch {
+	case s.err != nil:
+		statusStyle = statusStyle.Foreground(lipgloss.Color("#FF0000"))
+		b.WriteString(statusStyle.Render(styles.AddLetterSpacing("Validation failed: " + s.err.Error())))
+		b.WriteString("\n")
+	case len(s.issues) == 0:
+		b.WriteString(statusStyle.Render(styles.AddLetterSpacing(fmt.Sprintf("All records valid (%d checked)", s.checked))))
+		b.WriteString("\n")
+	default:
+		statusStyle = statusStyle.Foreground(lipgloss.Color("#FF0000"))
+		b.WriteString(statusStyle.Render(styles.AddLetterSpacing(fmt.Sprintf("%d of %d record(s) have problems:", len(s.issues), s.checked))))
+		b.WriteString("\n")
+		// List each invalid book by ID and title with its problems
+		for _, issue := range s.issues {
+			problems := make([]string, len(issue.Errors))
+			for i, err := range issue.Errors {
+				problems[i] = err.Error()
+			}
+			b.WriteString(styles.ErrorStyle.Render(fmt.Sprintf("• #%d %q - %s", issue.ID, issue.Title, strings.Join(problems, "; "))))
+			b.WriteString("\n")
+		}
+	}
+
+	b.WriteString("\n" + renderHelp(help.Validate))
+
+	return b.String()
+}
+
+// validateCollectionCmd loads every book and validates it asynchronously,
+// reporting the result as a ValidateCollectionMsg.
+func (s *ValidateScreen) validateCollectionCmd() tea.Cmd {
+	return func() tea.Msg {
+		books, err := s.db.LoadBooks()
+		if err != nil {
+			return messages.ValidateCollectionMsg{Err: err}
+		}
+		return messages.ValidateCollectionMsg{Checked: len(books), Issues: validation.ValidateBooks(books, config.GetNotesMaxLength())}
+	}
+}
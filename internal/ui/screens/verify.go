@@ -0,0 +1,195 @@
+package screens
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/papadavis47/libros/internal/database"
+	"github.com/papadavis47/libros/internal/factory"
+	"github.com/papadavis47/libros/internal/help"
+	"github.com/papadavis47/libros/internal/messages"
+	"github.com/papadavis47/libros/internal/models"
+	"github.com/papadavis47/libros/internal/services"
+	"github.com/papadavis47/libros/internal/styles"
+)
+
+// VerifyState represents the current step of the export verification
+type VerifyState int
+
+const (
+	VerifyPathInput VerifyState = iota // Getting the JSON export's path from the user
+	Verifying                          // Reading the export and counting the collection
+	VerifyResult                       // Showing whether the export is complete
+)
+
+// VerifyExportScreen checks that a JSON export holds as many books as it declares,
+// and compares that count with the books in the collection now.
+type VerifyExportScreen struct {
+	db          *database.DB
+	state       VerifyState
+	pathInput   textinput.Model
+	defaultPath string // Where the JSON export is written by default, used when no path is typed
+	filePath    string
+	result      models.ExportVerification
+	bookCount   int
+	status      string
+	isError     bool
+}
+
+func NewVerifyExportScreen(db *database.DB) *VerifyExportScreen {
+	homeDir, _ := os.UserHomeDir()
+	defaultPath := filepath.Join(homeDir, ".libros", "exports", "books.json")
+	return &VerifyExportScreen{
+		db:          db,
+		pathInput:   factory.CreatePathInput(defaultPath),
+		defaultPath: defaultPath,
+	}
+}
+
+// Reset clears the previous file and result so the screen starts fresh
+// each time it is opened. It returns the command that focuses the path input.
+func (s *VerifyExportScreen) Reset() tea.Cmd {
+	s.state = VerifyPathInput
+	s.pathInput.Reset()
+	s.filePath = ""
+	s.result = models.ExportVerification{}
+	s.bookCount = 0
+	s.status = ""
+	s.isError = false
+	return s.pathInput.Focus()
+}
+
+func (s *VerifyExportScreen) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+func (s *VerifyExportScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch s.state {
+		case VerifyPathInput:
+			switch msg.String() {
+			case "enter":
+				path := s.defaultPath
+				if input := strings.TrimSpace(s.pathInput.Value()); input != "" {
+					var err error
+					if path, err = expandHome(input); err != nil {
+						s.status = err.Error()
+						s.isError = true
+						return s, nil
+					}
+				}
+				s.filePath = path
+				s.status = ""
+				s.isError = false
+				s.pathInput.Blur()
+				s.state = Verifying
+				return s, s.verifyCmd(path)
+			case "esc":
+				return s, SwitchScreenCmd(models.UtilitiesScreen)
+			}
+		case VerifyResult:
+			switch msg.String() {
+			case "enter", "esc":
+				return s, SwitchScreenCmd(models.UtilitiesScreen)
+			case "v":
+				// Check another file
+				s.state = VerifyPathInput
+				return s, s.pathInput.Focus()
+			}
+			return s, nil
+		default:
+			// Ignore input while the export is read
+			return s, nil
+		}
+
+	case messages.VerifyExportMsg:
+		s.state = VerifyResult
+		s.result = msg.Result
+		s.bookCount = msg.BookCount
+		switch {
+		case msg.Err != nil:
+			s.status = "Verification failed: " + msg.Err.Error()
+			s.isError = true
+		case !msg.Result.Complete():
+			s.status = fmt.Sprintf("Mismatch: the export declares %d book(s) but holds %d. It may be truncated or edited", msg.Result.TotalBooks, msg.Result.BooksFound)
+			s.isError = true
+		default:
+			s.status = fmt.Sprintf("The export is complete: all %d book(s) it declares are present", msg.Result.BooksFound)
+			s.isError = false
+		}
+		return s, nil
+	}
+
+	var cmd tea.Cmd
+	if s.state == VerifyPathInput {
+		s.pathInput, cmd = s.pathInput.Update(msg)
+	}
+	return s, cmd
+}
+
+func (s *VerifyExportScreen) View() string {
+	var b strings.Builder
+
+	b.WriteString("\n")
+	b.WriteString(styles.TitleStyle().Render(styles.Label("Ｖｅｒｉｆｙ　Ｅｘｐｏｒｔ")))
+	b.WriteString("\n\n")
+
+	statusStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#00FF00")).
+		Bold(true).
+		Padding(1, 0).
+		PaddingLeft(3)
+	if s.isError {
+		statusStyle = statusStyle.Foreground(lipgloss.Color("#FF0000"))
+	}
+
+	switch s.state {
+	case VerifyPathInput:
+		b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing("Enter the path of a JSON export, or press Enter to check the default:")))
+		b.WriteString("\n\n")
+		b.WriteString(s.pathInput.View())
+		b.WriteString("\n")
+		if s.status != "" {
+			b.WriteString(statusStyle.Render(styles.AddLetterSpacing(s.status)))
+			b.WriteString("\n")
+		}
+		b.WriteString("\n" + renderHelp(help.Verify))
+
+	case Verifying:
+		b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing("Verifying " + s.filePath + "...")))
+		b.WriteString("\n")
+
+	case VerifyResult:
+		b.WriteString(styles.BlurredStyle.Render(styles.AddLetterSpacing("File: " + s.filePath)))
+		b.WriteString("\n")
+		b.WriteString(statusStyle.Render(styles.AddLetterSpacing(s.status)))
+		b.WriteString("\n")
+		// The collection may have changed since the export was made, so this is only a warning
+		if !s.isError && s.result.BooksFound != s.bookCount {
+			b.WriteString(styles.ErrorStyle.Render(styles.AddLetterSpacing(fmt.Sprintf("The collection now has %d book(s). The export may cover a date range, or predate recent changes", s.bookCount))))
+			b.WriteString("\n")
+		}
+		b.WriteString("\n" + renderHelp(help.VerifyResult))
+	}
+
+	return b.String()
+}
+
+// verifyCmd reads the export and counts the collection asynchronously,
+// reporting the result as a VerifyExportMsg.
+func (s *VerifyExportScreen) verifyCmd(path string) tea.Cmd {
+	return func() tea.Msg {
+		result, err := services.NewBackupService().VerifyJSONExport(path)
+		if err != nil {
+			return messages.VerifyExportMsg{Err: err}
+		}
+		count, err := s.db.GetBookCount()
+		return messages.VerifyExportMsg{Result: result, BookCount: count, Err: err}
+	}
+}
@@ -0,0 +1,68 @@
+package utils
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/papadavis47/libros/internal/models"
+)
+
+// Citation styles supported by FormatCitation
+const (
+	CitationAPA = "apa"
+	CitationMLA = "mla"
+)
+
+// FormatCitation formats a simple citation for a book in the given style.
+// APA gives "Herbert, F. Dune." and MLA gives "Herbert, Frank. Dune." Libros does not
+// record a publication year, so it is left out, as is a blank author. An unknown style
+// is formatted as APA.
+//
+// Parameters:
+//   - book: Book to cite
+//   - style: CitationAPA or CitationMLA (case-insensitive)
+//
+// Returns:
+//   - string: Citation text, or an empty string if the book has no title or author
+func FormatCitation(book models.Book, style string) string {
+	var parts []string
+	if author := citationAuthor(book.Author, strings.ToLower(strings.TrimSpace(style))); author != "" {
+		parts = append(parts, withPeriod(author))
+	}
+	if title := strings.Join(strings.Fields(book.Title), " "); title != "" {
+		parts = append(parts, withPeriod(title))
+	}
+	return strings.Join(parts, " ")
+}
+
+// citationAuthor inverts an author name to "Last, First" for MLA or "Last, F." for APA.
+// Single names, and names already written "Last, First", are kept as they are.
+func citationAuthor(author, style string) string {
+	author = strings.Join(strings.Fields(author), " ")
+	if author == "" || strings.Contains(author, ",") {
+		return author
+	}
+	names := strings.Fields(author)
+	if len(names) == 1 {
+		return author
+	}
+	last, given := names[len(names)-1], names[:len(names)-1]
+	if style == CitationMLA {
+		return last + ", " + strings.Join(given, " ")
+	}
+	initials := make([]string, len(given))
+	for i, name := range given {
+		r, _ := utf8.DecodeRuneInString(name)
+		initials[i] = string(unicode.ToUpper(r)) + "."
+	}
+	return last + ", " + strings.Join(initials, " ")
+}
+
+// withPeriod ends a citation part with a period unless it already ends in punctuation
+func withPeriod(s string) string {
+	if strings.HasSuffix(s, ".") || strings.HasSuffix(s, "?") || strings.HasSuffix(s, "!") {
+		return s
+	}
+	return s + "."
+}
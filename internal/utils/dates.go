@@ -0,0 +1,29 @@
+package utils
+
+import "time"
+
+// StartOfWeek returns midnight on the Monday of the week containing t,
+// in t's location.
+//
+// Parameters:
+//   - t: Any moment within the week
+//
+// Returns:
+//   - time.Time: Start of that week
+func StartOfWeek(t time.Time) time.Time {
+	// Weekday counts from Sunday, so shift it to count days since Monday
+	daysSinceMonday := (int(t.Weekday()) + 6) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-daysSinceMonday, 0, 0, 0, 0, t.Location())
+}
+
+// StartOfMonth returns midnight on the first day of the month containing t,
+// in t's location.
+//
+// Parameters:
+//   - t: Any moment within the month
+//
+// Returns:
+//   - time.Time: Start of that month
+func StartOfMonth(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+}
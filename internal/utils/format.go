@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/papadavis47/libros/internal/models"
 )
@@ -17,23 +18,53 @@ import (
 // Returns:
 //   - string: Formatted date with month name, day with ordinal suffix, and year
 func FormatDate(t time.Time) string {
-	day := t.Day()
+	return fmt.Sprintf("%s %s, %d", t.Format("January"), FormatOrdinal(t.Day()), t.Year())
+}
+
+// FormatOrdinal formats a number with its English ordinal suffix, such as "1st", "12th" or "23rd".
+func FormatOrdinal(n int) string {
 	var suffix string
-	// Determine appropriate ordinal suffix for the day
+	// Determine appropriate ordinal suffix for the number
 	switch {
-	case day >= 11 && day <= 13:
+	case n%100 >= 11 && n%100 <= 13:
 		// Special case: 11th, 12th, 13th (not 11st, 12nd, 13rd)
 		suffix = "th"
-	case day%10 == 1:
+	case n%10 == 1:
 		suffix = "st" // 1st, 21st, 31st
-	case day%10 == 2:
+	case n%10 == 2:
 		suffix = "nd" // 2nd, 22nd
-	case day%10 == 3:
+	case n%10 == 3:
 		suffix = "rd" // 3rd, 23rd
 	default:
 		suffix = "th" // 4th, 5th, 6th, 7th, 8th, 9th, 10th, etc.
 	}
-	return fmt.Sprintf("%s %d%s, %d", t.Format("January"), day, suffix, t.Year())
+	return fmt.Sprintf("%d%s", n, suffix)
+}
+
+// FormatBar draws value as a bar of block characters, scaled so maxValue fills width
+// Any non-zero value gets at least one block so small counts stay visible
+func FormatBar(value, maxValue, width int) string {
+	if value <= 0 || maxValue <= 0 || width <= 0 {
+		return ""
+	}
+	blocks := max(value*width/maxValue, 1)
+	return strings.Repeat("█", min(blocks, width))
+}
+
+// FormatFileSize formats a byte count using binary units, e.g. "512 B" or "1.5 KB"
+func FormatFileSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+	value := float64(size) / unit
+	for _, suffix := range []string{"KB", "MB", "GB"} {
+		if value < unit {
+			return fmt.Sprintf("%.1f %s", value, suffix)
+		}
+		value /= unit
+	}
+	return fmt.Sprintf("%.1f TB", value)
 }
 
 // FormatBookType converts BookType enum values to capitalized display names
@@ -73,4 +104,60 @@ func FormatBookType(bookType interface{}) string {
 	default:
 		return fmt.Sprintf("%v", bookType)
 	}
-}
\ No newline at end of file
+}
+
+// WrapText wraps long text to fit within a specified width by breaking at word boundaries.
+// This ensures that long notes are displayed properly without horizontal scrolling.
+// Ordinary words are kept whole; a word longer than the width on its own, such as a
+// URL, is broken across lines. Widths are counted in characters, not bytes.
+//
+// Parameters:
+//   - text: Original text to wrap
+//   - width: Maximum line width in characters
+//
+// Returns:
+//   - string: Text with newlines inserted to fit within specified width
+func WrapText(text string, width int) string {
+	if width <= 0 || utf8.RuneCountInString(text) <= width {
+		return text // No wrapping needed
+	}
+
+	var result []string
+	words := strings.Fields(text) // Split into words
+	if len(words) == 0 {
+		return text // Handle edge case of empty or whitespace-only text
+	}
+
+	currentLine := ""
+	for _, word := range words {
+		// Hard-break a word that cannot fit on a line of its own
+		for utf8.RuneCountInString(word) > width {
+			if currentLine != "" {
+				result = append(result, currentLine)
+				currentLine = ""
+			}
+			runes := []rune(word)
+			result = append(result, string(runes[:width]))
+			word = string(runes[width:])
+		}
+
+		switch {
+		case currentLine == "":
+			// Start the line with this word
+			currentLine = word
+		case utf8.RuneCountInString(currentLine)+1+utf8.RuneCountInString(word) <= width:
+			// Add word to current line
+			currentLine += " " + word
+		default:
+			// Start new line with this word
+			result = append(result, currentLine)
+			currentLine = word
+		}
+	}
+	// Add the final line
+	if currentLine != "" {
+		result = append(result, currentLine)
+	}
+
+	return strings.Join(result, "\n")
+}
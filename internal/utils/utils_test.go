@@ -93,6 +93,32 @@ func TestFormatDate(t *testing.T) {
 	}
 }
 
+// TestFormatOrdinal tests the ordinal suffixes used for dates and the detail stats line
+func TestFormatOrdinal(t *testing.T) {
+	tests := []struct {
+		n        int
+		expected string
+	}{
+		{1, "1st"},
+		{2, "2nd"},
+		{3, "3rd"},
+		{4, "4th"},
+		{11, "11th"},
+		{12, "12th"},
+		{13, "13th"},
+		{21, "21st"},
+		{101, "101st"},
+		{111, "111th"},
+		{112, "112th"},
+	}
+
+	for _, tt := range tests {
+		if got := FormatOrdinal(tt.n); got != tt.expected {
+			t.Errorf("FormatOrdinal(%d) = %q, want %q", tt.n, got, tt.expected)
+		}
+	}
+}
+
 // TestFormatBookType_WithEnum tests book type formatting with enum values
 // This function handles the conversion from internal enum types to display strings
 func TestFormatBookType_WithEnum(t *testing.T) {
@@ -168,6 +194,118 @@ func TestFormatBookType_WithOtherTypes(t *testing.T) {
 	}
 }
 
+// TestFormatFileSize tests formatting byte counts with binary units
+func TestFormatFileSize(t *testing.T) {
+	tests := []struct {
+		size     int64
+		expected string
+	}{
+		{0, "0 B"},
+		{1023, "1023 B"},
+		{1024, "1.0 KB"},
+		{1536, "1.5 KB"},
+		{5 * 1024 * 1024, "5.0 MB"},
+		{3 * 1024 * 1024 * 1024, "3.0 GB"},
+	}
+
+	for _, tt := range tests {
+		if got := FormatFileSize(tt.size); got != tt.expected {
+			t.Errorf("FormatFileSize(%d) = %q, want %q", tt.size, got, tt.expected)
+		}
+	}
+}
+
+// TestFormatBar tests scaling counts to bars for the statistics chart
+func TestFormatBar(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    int
+		maxValue int
+		width    int
+		expected string
+	}{
+		{"largest value fills width", 10, 10, 5, "█████"},
+		{"half value", 5, 10, 10, "█████"},
+		{"small value keeps one block", 1, 100, 10, "█"},
+		{"zero value is empty", 0, 10, 10, ""},
+		{"empty chart", 0, 0, 10, ""},
+		{"value over maximum capped", 20, 10, 4, "████"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatBar(tt.value, tt.maxValue, tt.width); got != tt.expected {
+				t.Errorf("FormatBar(%d, %d, %d) = %q, want %q", tt.value, tt.maxValue, tt.width, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestWrapText tests word wrapping used for notes in the detail view and text export
+func TestWrapText(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		width    int
+		expected string
+	}{
+		{"short text unchanged", "Short note", 20, "Short note"},
+		{"exact width unchanged", "abcde", 5, "abcde"},
+		{"wraps at word boundary", "the quick brown fox jumps", 10, "the quick\nbrown fox\njumps"},
+		{"collapses extra whitespace", "one   two    three four", 9, "one two\nthree\nfour"},
+		{"whitespace only", "          ", 5, "          "},
+		{"breaks a word longer than the width", "abcdefghijkl", 5, "abcde\nfghij\nkl"},
+		{"breaks a long URL in a sentence", "see https://example.com/a/very/long/path here", 12, "see\nhttps://exam\nple.com/a/ve\nry/long/path\nhere"},
+		{"long word filling whole lines", "abcdefghij xy", 5, "abcde\nfghij\nxy"},
+		{"counts characters, not bytes", "ñandú über", 10, "ñandú über"},
+		{"breaks multibyte words on characters", "éééééé", 4, "éééé\néé"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := WrapText(tt.text, tt.width)
+			if result != tt.expected {
+				t.Errorf("WrapText(%q, %d) = %q, want %q", tt.text, tt.width, result, tt.expected)
+			}
+		})
+	}
+}
+
+// TestStartOfWeek tests that any moment maps to midnight on the Monday of its week
+func TestStartOfWeek(t *testing.T) {
+	monday := time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		date time.Time
+	}{
+		{"monday midnight", monday},
+		{"wednesday afternoon", time.Date(2024, 3, 13, 15, 30, 0, 0, time.UTC)},
+		{"sunday night", time.Date(2024, 3, 17, 23, 59, 59, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := StartOfWeek(tt.date); !got.Equal(monday) {
+				t.Errorf("StartOfWeek(%v) = %v, want %v", tt.date, got, monday)
+			}
+		})
+	}
+
+	// Weeks that begin in the previous month roll back across the boundary
+	if got, want := StartOfWeek(time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)), time.Date(2024, 2, 26, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("StartOfWeek(March 1st) = %v, want %v", got, want)
+	}
+}
+
+// TestStartOfMonth tests that any moment maps to midnight on the first of its month
+func TestStartOfMonth(t *testing.T) {
+	date := time.Date(2024, 2, 29, 18, 45, 0, 0, time.UTC)
+	want := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	if got := StartOfMonth(date); !got.Equal(want) {
+		t.Errorf("StartOfMonth(%v) = %v, want %v", date, got, want)
+	}
+}
+
 // BenchmarkFormatDate benchmarks the date formatting function
 // This ensures the formatting performance is acceptable for UI rendering
 func BenchmarkFormatDate(b *testing.B) {
@@ -188,4 +326,33 @@ func BenchmarkFormatBookType(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		FormatBookType(bookType)
 	}
-}
\ No newline at end of file
+}
+// TestFormatCitation tests APA and MLA citations, including books missing an author
+func TestFormatCitation(t *testing.T) {
+	tests := []struct {
+		name     string
+		book     models.Book
+		style    string
+		expected string
+	}{
+		{"APA", models.Book{Title: "Dune", Author: "Frank Herbert"}, CitationAPA, "Herbert, F. Dune."},
+		{"APA with middle name", models.Book{Title: "Moby Dick", Author: "Herman  Melville"}, CitationAPA, "Melville, H. Moby Dick."},
+		{"APA initials", models.Book{Title: "The Hobbit", Author: "John Ronald Tolkien"}, CitationAPA, "Tolkien, J. R. The Hobbit."},
+		{"MLA", models.Book{Title: "Dune", Author: "Frank Herbert"}, CitationMLA, "Herbert, Frank. Dune."},
+		{"MLA upper case style", models.Book{Title: "Dune", Author: "Frank Herbert"}, "MLA", "Herbert, Frank. Dune."},
+		{"single name author", models.Book{Title: "The Republic", Author: "Plato"}, CitationMLA, "Plato. The Republic."},
+		{"already inverted", models.Book{Title: "Emma", Author: "Austen, Jane"}, CitationAPA, "Austen, Jane. Emma."},
+		{"title ending in punctuation", models.Book{Title: "Who Moved My Cheese?", Author: "Spencer Johnson"}, CitationAPA, "Johnson, S. Who Moved My Cheese?"},
+		{"missing author", models.Book{Title: "Beowulf"}, CitationAPA, "Beowulf."},
+		{"unknown style uses APA", models.Book{Title: "Dune", Author: "Frank Herbert"}, "chicago", "Herbert, F. Dune."},
+		{"empty book", models.Book{}, CitationMLA, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatCitation(tt.book, tt.style); got != tt.expected {
+				t.Errorf("FormatCitation(%q) = %q, want %q", tt.style, got, tt.expected)
+			}
+		})
+	}
+}
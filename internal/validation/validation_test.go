@@ -5,7 +5,9 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/papadavis47/libros/internal/constants"
 	"github.com/papadavis47/libros/internal/models"
 )
 
@@ -110,7 +112,7 @@ func TestValidateBook(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			errors := ValidateBook(tt.book)
+			errors := ValidateBook(tt.book, constants.NotesMaxLength)
 			
 			if len(errors) != tt.expectedCount {
 				t.Errorf("ValidateBook() returned %d errors, want %d", len(errors), tt.expectedCount)
@@ -145,6 +147,11 @@ func TestValidateTitle(t *testing.T) {
 		{"single character title", "A", false},
 		{"title with special characters", "Design Patterns: Elements of Reusable Object-Oriented Software", false},
 		{"title with numbers", "Catch-22", false},
+		{"title with accents", "Cien años de soledad", false},
+		{"title with emoji", "The Very Hungry Caterpillar 🐛", false},
+		{"title with embedded tab", "The Great\tGatsby", true},
+		{"title with NUL byte", "The Great\x00Gatsby", true},
+		{"title with ANSI escape", "\x1b[31mThe Great Gatsby\x1b[0m", true},
 	}
 
 	for _, tt := range tests {
@@ -179,6 +186,11 @@ func TestValidateAuthor(t *testing.T) {
 		{"single character author", "X", false},
 		{"author with multiple names", "J.R.R. Tolkien", false},
 		{"author with Jr./Sr.", "Martin Luther King Jr.", false},
+		{"author with accents", "Gabriel García Márquez", false},
+		{"author with non-Latin script", "村上春樹", false},
+		{"author with embedded tab", "F. Scott\tFitzgerald", true},
+		{"author with NUL byte", "F. Scott\x00Fitzgerald", true},
+		{"author with ANSI escape", "\x1b[1mF. Scott Fitzgerald", true},
 	}
 
 	for _, tt := range tests {
@@ -214,7 +226,7 @@ func TestValidateNotes(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := ValidateNotes(tt.notes)
+			err := ValidateNotes(tt.notes, constants.NotesMaxLength)
 			
 			if tt.shouldErr && err == nil {
 				t.Errorf("ValidateNotes(%q) should have returned an error", tt.notes)
@@ -224,6 +236,10 @@ func TestValidateNotes(t *testing.T) {
 			}
 		})
 	}
+	// A limit raised in the config lets longer notes through
+	if err := ValidateNotes(strings.Repeat("a", 1500), 2000); err != nil {
+		t.Errorf("ValidateNotes() with a raised limit should not have returned an error: %v", err)
+	}
 }
 
 // TestValidateFilePath tests file path validation for export operations
@@ -338,4 +354,94 @@ func TestTrimAndValidateInput(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+// TestParseDateInput tests parsing of user-entered dates
+// Blank input means "no date" and malformed input must be rejected
+func TestParseDateInput(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		expected    time.Time
+		expectError bool
+	}{
+		{"valid date", "2024-03-15", time.Date(2024, 3, 15, 0, 0, 0, 0, time.Local), false},
+		{"valid date with whitespace", "  2023-12-01 ", time.Date(2023, 12, 1, 0, 0, 0, 0, time.Local), false},
+		{"blank input", "", time.Time{}, false},
+		{"whitespace only", "   ", time.Time{}, false},
+		{"wrong format", "03/15/2024", time.Time{}, true},
+		{"invalid month", "2024-13-01", time.Time{}, true},
+		{"free text", "yesterday", time.Time{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseDateInput(tt.input)
+			if tt.expectError && err == nil {
+				t.Errorf("ParseDateInput(%q) expected error but got none", tt.input)
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("ParseDateInput(%q) unexpected error: %v", tt.input, err)
+			}
+			if !result.Equal(tt.expected) {
+				t.Errorf("ParseDateInput(%q) = %v, want %v", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+// TestValidateDateRange tests start/end ordering with optional open bounds
+func TestValidateDateRange(t *testing.T) {
+	jan := time.Date(2024, 1, 1, 0, 0, 0, 0, time.Local)
+	feb := time.Date(2024, 2, 1, 0, 0, 0, 0, time.Local)
+
+	tests := []struct {
+		name        string
+		start       time.Time
+		end         time.Time
+		expectError bool
+	}{
+		{"start before end", jan, feb, false},
+		{"same day", jan, jan, false},
+		{"start after end", feb, jan, true},
+		{"open start", time.Time{}, feb, false},
+		{"open end", jan, time.Time{}, false},
+		{"fully open", time.Time{}, time.Time{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateDateRange(tt.start, tt.end)
+			if tt.expectError && err == nil {
+				t.Error("ValidateDateRange() expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("ValidateDateRange() unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+// TestValidateBooks tests that only books failing validation are reported, by ID and title
+func TestValidateBooks(t *testing.T) {
+	books := []models.Book{
+		{ID: 1, Title: "Valid Book", Author: "Author"},
+		{ID: 2, Title: "No Author", Author: "   "},
+		{ID: 3, Title: "", Author: ""},
+		{ID: 4, Title: "Another Valid Book", Author: "Author", Notes: "Short notes"},
+	}
+
+	issues := ValidateBooks(books, constants.NotesMaxLength)
+	if len(issues) != 2 {
+		t.Fatalf("ValidateBooks() returned %d issues, want 2", len(issues))
+	}
+	if issues[0].ID != 2 || issues[0].Title != "No Author" || len(issues[0].Errors) != 1 {
+		t.Errorf("First issue = %+v, want book 2 with one error", issues[0])
+	}
+	if issues[1].ID != 3 || len(issues[1].Errors) != 2 {
+		t.Errorf("Second issue = %+v, want book 3 with two errors", issues[1])
+	}
+
+	if issues := ValidateBooks(books[:1], constants.NotesMaxLength); len(issues) != 0 {
+		t.Errorf("ValidateBooks() on valid books returned %d issues, want 0", len(issues))
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
+	"unicode"
 
 	"github.com/papadavis47/libros/internal/constants"
 	"github.com/papadavis47/libros/internal/models"
@@ -21,7 +23,8 @@ func (e BookValidationError) Error() string {
 }
 
 // ValidateBook validates all book fields and returns any validation errors
-func ValidateBook(book *models.Book) []error {
+// Notes are checked against notesMaxLength, normally config.GetNotesMaxLength()
+func ValidateBook(book *models.Book, notesMaxLength int) []error {
 	var errors []error
 	
 	// Validate title
@@ -36,7 +39,7 @@ func ValidateBook(book *models.Book) []error {
 	
 	// Validate notes (optional field, only validate if present)
 	if book.Notes != "" {
-		if err := ValidateNotes(book.Notes); err != nil {
+		if err := ValidateNotes(book.Notes, notesMaxLength); err != nil {
 			errors = append(errors, err)
 		}
 	}
@@ -44,6 +47,25 @@ func ValidateBook(book *models.Book) []error {
 	return errors
 }
 
+// RecordIssue describes a stored book that fails validation
+type RecordIssue struct {
+	ID     int     // Database ID of the book
+	Title  string  // Title as stored, to help find the record
+	Errors []error // Validation errors reported for the book
+}
+
+// ValidateBooks runs every book through ValidateBook and returns the ones with problems
+// The books are only read, so this is safe to run over the whole collection
+func ValidateBooks(books []models.Book, notesMaxLength int) []RecordIssue {
+	var issues []RecordIssue
+	for i := range books {
+		if errs := ValidateBook(&books[i], notesMaxLength); len(errs) > 0 {
+			issues = append(issues, RecordIssue{ID: books[i].ID, Title: books[i].Title, Errors: errs})
+		}
+	}
+	return issues
+}
+
 // ValidateTitle validates the book title field
 func ValidateTitle(title string) error {
 	title = strings.TrimSpace(title)
@@ -59,6 +81,12 @@ func ValidateTitle(title string) error {
 			Message: "title exceeds maximum length",
 		}
 	}
+	if HasControlChars(title) {
+		return BookValidationError{
+			Field:   "title",
+			Message: "title contains control characters (such as tabs or escape codes)",
+		}
+	}
 	return nil
 }
 
@@ -77,12 +105,26 @@ func ValidateAuthor(author string) error {
 			Message: "author exceeds maximum length",
 		}
 	}
+	if HasControlChars(author) {
+		return BookValidationError{
+			Field:   "author",
+			Message: "author contains control characters (such as tabs or escape codes)",
+		}
+	}
 	return nil
 }
 
-// ValidateNotes validates the book notes field
-func ValidateNotes(notes string) error {
-	if len(notes) > constants.NotesMaxLength {
+// HasControlChars reports whether text contains a control character such as a tab,
+// a NUL byte or the escape that starts an ANSI sequence
+// Letters, accents and emoji in any script are not control characters
+func HasControlChars(text string) bool {
+	return strings.IndexFunc(text, unicode.IsControl) >= 0
+}
+
+// ValidateNotes validates the book notes field against a notes limit
+// The limit is passed in, normally config.GetNotesMaxLength(), so validation never reads the config file
+func ValidateNotes(notes string, maxLength int) error {
+	if len(notes) > maxLength {
 		return BookValidationError{
 			Field:   "notes",
 			Message: "notes exceed maximum length",
@@ -138,4 +180,31 @@ func TrimAndValidateInput(input, fieldName string) (string, error) {
 		return "", errors.New(fieldName + " cannot be empty")
 	}
 	return trimmed, nil
-}
\ No newline at end of file
+}
+
+// DateInputLayout is the date format users type when entering dates (e.g. 2024-03-15)
+const DateInputLayout = "2006-01-02"
+
+// ParseDateInput parses a user-entered date in YYYY-MM-DD format using the local time zone
+// Blank input is allowed and returns the zero time, meaning "no date"
+func ParseDateInput(input string) (time.Time, error) {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return time.Time{}, nil
+	}
+
+	date, err := time.ParseInLocation(DateInputLayout, input, time.Local)
+	if err != nil {
+		return time.Time{}, errors.New("invalid date \"" + input + "\" (use YYYY-MM-DD)")
+	}
+	return date, nil
+}
+
+// ValidateDateRange checks that a start date does not come after an end date
+// Either bound may be the zero time to leave that side of the range open
+func ValidateDateRange(start, end time.Time) error {
+	if !start.IsZero() && !end.IsZero() && start.After(end) {
+		return errors.New("start date must be on or before end date")
+	}
+	return nil
+}